@@ -0,0 +1,78 @@
+package enum
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// DecodeSlice decodes a JSON array of enum names, resolving each name through
+// the set. Instead of stopping at the first problem, it reports every invalid
+// entry together with its index as a joined error
+func DecodeSlice[T any](set *EnumSet[T], data []byte) ([]Enum[T], error) {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	result := make([]Enum[T], 0, len(raw))
+	var errs []error
+	for i, item := range raw {
+		e, err := decodeName(set, item)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid value at index %d: %w", i, err))
+			continue
+		}
+		result = append(result, e)
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return result, nil
+}
+
+// DecodeMap decodes a JSON object whose values are enum names, resolving each
+// name through the set. Every invalid entry is reported together with its key
+// as a joined error, in key order
+func DecodeMap[T any](set *EnumSet[T], data []byte) (map[string]Enum[T], error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(raw))
+	for key := range raw {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	result := make(map[string]Enum[T], len(raw))
+	var errs []error
+	for _, key := range keys {
+		e, err := decodeName(set, raw[key])
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid value at key %q: %w", key, err))
+			continue
+		}
+		result[key] = e
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return result, nil
+}
+
+// decodeName decodes a single JSON string and finds the enum with that name
+func decodeName[T any](set *EnumSet[T], data json.RawMessage) (Enum[T], error) {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return Enum[T]{}, err
+	}
+	if e, found := set.FindByName(name).GetIfPresent(); found {
+		return e, nil
+	}
+	return Enum[T]{}, fmt.Errorf("%w: %s", ErrNameNotFound, name)
+}
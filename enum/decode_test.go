@@ -0,0 +1,96 @@
+package enum
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+var colors = FromValues([]Enum[ColorEnum]{RED, GREEN, BLUE})
+
+// TestDecodeSlice tests decoding a JSON array of enum names
+func TestDecodeSlice(t *testing.T) {
+	values, err := DecodeSlice(colors, []byte(`["BLUE","RED","BLUE"]`))
+	if err != nil {
+		t.Fatalf("DecodeSlice() error = %v", err)
+	}
+	if got := enumNames(values); !equalNames(got, []string{"BLUE", "RED", "BLUE"}) {
+		t.Errorf("DecodeSlice() = %v, want [BLUE RED BLUE]", got)
+	}
+	if values[1].Value.Hex != "#FF0000" {
+		t.Errorf("DecodeSlice() didn't resolve the enum value, got %v", values[1].Value)
+	}
+
+	values, err = DecodeSlice(colors, []byte(`[]`))
+	if err != nil || values == nil || len(values) != 0 {
+		t.Errorf("DecodeSlice(empty) = %v, %v, want empty slice", values, err)
+	}
+}
+
+// TestDecodeSliceErrors tests that every invalid entry is reported with its index
+func TestDecodeSliceErrors(t *testing.T) {
+	values, err := DecodeSlice(colors, []byte(`["RED","PURPLE","GREEN",42,"BLU"]`))
+	if values != nil {
+		t.Errorf("DecodeSlice() = %v, want nil on error", values)
+	}
+	if !errors.Is(err, ErrNameNotFound) {
+		t.Errorf("DecodeSlice() error = %v, want it to wrap %v", err, ErrNameNotFound)
+	}
+
+	for _, want := range []string{
+		"invalid value at index 1: enum name not found: PURPLE",
+		"invalid value at index 3:",
+		"invalid value at index 4: enum name not found: BLU",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("DecodeSlice() error = %q, want it to contain %q", err, want)
+		}
+	}
+	if strings.Contains(err.Error(), "index 0") || strings.Contains(err.Error(), "index 2") {
+		t.Errorf("DecodeSlice() error = %q reports valid entries", err)
+	}
+
+	if _, err := DecodeSlice(colors, []byte(`{"a":"RED"}`)); err == nil {
+		t.Errorf("DecodeSlice() expected an error for a non-array input")
+	}
+}
+
+// TestDecodeMap tests decoding a JSON object whose values are enum names
+func TestDecodeMap(t *testing.T) {
+	values, err := DecodeMap(colors, []byte(`{"background":"BLUE","text":"RED"}`))
+	if err != nil {
+		t.Fatalf("DecodeMap() error = %v", err)
+	}
+	if len(values) != 2 || !values["background"].Equal(BLUE) || !values["text"].Equal(RED) {
+		t.Errorf("DecodeMap() = %v, want background=BLUE text=RED", values)
+	}
+
+	_, err = DecodeMap(colors, []byte(`{"text":"RED","border":"GRAY","accent":"TEAL"}`))
+	if !errors.Is(err, ErrNameNotFound) {
+		t.Fatalf("DecodeMap() error = %v, want it to wrap %v", err, ErrNameNotFound)
+	}
+	want := "invalid value at key \"accent\": enum name not found: TEAL\n" +
+		"invalid value at key \"border\": enum name not found: GRAY"
+	if err.Error() != want {
+		t.Errorf("DecodeMap() error = %q, want %q", err, want)
+	}
+}
+
+// TestDecodeInsideStruct tests using the decode helpers for struct fields
+func TestDecodeInsideStruct(t *testing.T) {
+	var config struct {
+		Palette json.RawMessage `json:"palette"`
+	}
+	if err := json.Unmarshal([]byte(`{"palette":["GREEN","RED"]}`), &config); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+
+	palette, err := DecodeSlice(colors, config.Palette)
+	if err != nil {
+		t.Fatalf("DecodeSlice() error = %v", err)
+	}
+	if got := enumNames(palette); !equalNames(got, []string{"GREEN", "RED"}) {
+		t.Errorf("DecodeSlice() = %v, want [GREEN RED]", got)
+	}
+}
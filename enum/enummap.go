@@ -0,0 +1,74 @@
+package enum
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tiagods/go-extras/optional"
+)
+
+// EnumMap associates a value with the enums of an EnumSet, keyed by name.
+// Only names that belong to the set are accepted, and iteration follows the
+// set order
+type EnumMap[T, V any] struct {
+	set    *EnumSet[T]
+	values map[string]V
+}
+
+// NewEnumMap creates an empty EnumMap for the enums of the given set
+func NewEnumMap[T, V any](set *EnumSet[T]) *EnumMap[T, V] {
+	return &EnumMap[T, V]{set: set, values: map[string]V{}}
+}
+
+// Put associates a value with the enum of the given name, returning an error
+// if the name is not part of the set
+func (m *EnumMap[T, V]) Put(name string, v V) error {
+	if !m.set.FindByName(name).IsPresent() {
+		return fmt.Errorf("%w: %s", ErrNameNotFound, name)
+	}
+	m.values[name] = v
+	return nil
+}
+
+// Get returns an Optional containing the value associated with the given name,
+// or an empty Optional if there is none
+func (m *EnumMap[T, V]) Get(name string) optional.Optional[V] {
+	if v, ok := m.values[name]; ok {
+		return optional.Of(v)
+	}
+	return optional.Empty[V]()
+}
+
+// Len returns the number of enums that have a value
+func (m *EnumMap[T, V]) Len() int {
+	return len(m.values)
+}
+
+// MustComplete returns an error listing every enum of the set without a value,
+// or nil if all of them have one. Call it at startup to guarantee coverage
+func (m *EnumMap[T, V]) MustComplete() error {
+	var missing []string
+	for _, e := range m.set.values {
+		if _, ok := m.values[e.Name]; !ok {
+			missing = append(missing, e.Name)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%w: missing %s", ErrIncompleteMap, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// ForEach executes an action for every enum that has a value, in set order.
+// When names repeat, only the first enum with the name is visited, matching
+// FindByName
+func (m *EnumMap[T, V]) ForEach(action func(e Enum[T], v V)) {
+	for i, e := range m.set.values {
+		if first, _ := m.set.position(e.Name); first != i {
+			continue
+		}
+		if v, ok := m.values[e.Name]; ok {
+			action(e, v)
+		}
+	}
+}
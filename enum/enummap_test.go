@@ -0,0 +1,117 @@
+package enum
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func testEnumMapSet() *EnumSet[TestEnum] {
+	return FromValues([]Enum[TestEnum]{TestFirst, TestSecond, TestThird})
+}
+
+// TestEnumMapPut tests the Put and Get methods of EnumMap
+func TestEnumMapPut(t *testing.T) {
+	m := NewEnumMap[TestEnum, int](testEnumMapSet())
+
+	tests := []struct {
+		name  string
+		key   string
+		value int
+		err   error
+	}{
+		{"Known name", "FIRST", 10, nil},
+		{"Another known name", "THIRD", 30, nil},
+		{"Overwrite known name", "FIRST", 11, nil},
+		{"Unknown name", "FOURTH", 40, ErrNameNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := m.Put(tt.key, tt.value); !errors.Is(err, tt.err) {
+				t.Errorf("EnumMap.Put(%v) error = %v, want %v", tt.key, err, tt.err)
+			}
+		})
+	}
+
+	if v := m.Get("FIRST").OrElse(0); v != 11 {
+		t.Errorf("EnumMap.Get(FIRST) = %v, want 11", v)
+	}
+	if m.Get("SECOND").IsPresent() {
+		t.Errorf("EnumMap.Get(SECOND) expected empty Optional")
+	}
+	if m.Get("FOURTH").IsPresent() {
+		t.Errorf("EnumMap.Get(FOURTH) expected empty Optional for rejected name")
+	}
+	if m.Len() != 2 {
+		t.Errorf("EnumMap.Len() = %v, want 2", m.Len())
+	}
+}
+
+// TestEnumMapMustComplete tests the MustComplete method of EnumMap
+func TestEnumMapMustComplete(t *testing.T) {
+	m := NewEnumMap[TestEnum, string](testEnumMapSet())
+	m.Put("SECOND", "b")
+
+	err := m.MustComplete()
+	if !errors.Is(err, ErrIncompleteMap) {
+		t.Fatalf("EnumMap.MustComplete() error = %v, want %v", err, ErrIncompleteMap)
+	}
+	if !strings.Contains(err.Error(), "FIRST, THIRD") {
+		t.Errorf("EnumMap.MustComplete() error = %v, want it to list FIRST, THIRD", err)
+	}
+
+	m.Put("FIRST", "a")
+	m.Put("THIRD", "c")
+	if err := m.MustComplete(); err != nil {
+		t.Errorf("EnumMap.MustComplete() error = %v, want nil", err)
+	}
+}
+
+// TestEnumMapForEach tests that EnumMap iteration follows the set order
+func TestEnumMapForEach(t *testing.T) {
+	set := testEnumMapSet()
+	m := NewEnumMap[TestEnum, int](set)
+	m.Put("THIRD", 3)
+	m.Put("FIRST", 1)
+
+	var visited []string
+	m.ForEach(func(e Enum[TestEnum], v int) {
+		if int(e.Value) != v {
+			t.Errorf("EnumMap.ForEach() got value %v for %v", v, e.Name)
+		}
+		visited = append(visited, e.Name)
+	})
+	if !equalNames(visited, []string{"FIRST", "THIRD"}) {
+		t.Errorf("EnumMap.ForEach() order = %v, want [FIRST THIRD]", visited)
+	}
+
+	set.SortByOrder(func(e TestEnum) int { return -int(e) })
+	visited = nil
+	m.ForEach(func(e Enum[TestEnum], v int) {
+		visited = append(visited, e.Name)
+	})
+	if !equalNames(visited, []string{"THIRD", "FIRST"}) {
+		t.Errorf("EnumMap.ForEach() order after sort = %v, want [THIRD FIRST]", visited)
+	}
+}
+
+// TestEnumMapForEachDuplicateNames tests that a repeated name is visited once,
+// with the first enum of that name
+func TestEnumMapForEachDuplicateNames(t *testing.T) {
+	set := FromValues([]Enum[TestEnum]{TestFirst, TestSecond, {Name: "FIRST", Value: THIRD}})
+	m := NewEnumMap[TestEnum, int](set)
+	m.Put("FIRST", 1)
+	m.Put("SECOND", 2)
+
+	var visited []Enum[TestEnum]
+	m.ForEach(func(e Enum[TestEnum], v int) {
+		visited = append(visited, e)
+	})
+	if !equalNames(enumNames(visited), []string{"FIRST", "SECOND"}) {
+		t.Fatalf("EnumMap.ForEach() visited %v, want [FIRST SECOND]", enumNames(visited))
+	}
+	if visited[0].Value != FIRST {
+		t.Errorf("EnumMap.ForEach() visited FIRST with value %v, want %v", visited[0].Value, FIRST)
+	}
+}
@@ -1,14 +1,35 @@
 package enum
 
 import (
+	"errors"
+	"fmt"
 	"sort"
+	"sync/atomic"
 
 	"github.com/tiagods/go-extras/optional"
 )
 
+// Common errors returned by the package
+var (
+	ErrNameNotFound  = errors.New("enum name not found")
+	ErrInvalidRange  = errors.New("invalid enum range")
+	ErrIncompleteMap = errors.New("enum map is incomplete")
+	ErrAmbiguousName = errors.New("ambiguous styled enum name")
+)
+
 // EnumSet is a collection of Enum values of the same type
 type EnumSet[T any] struct {
-	values []Enum[T]
+	values  []Enum[T]
+	derived atomic.Value // *derivedData
+}
+
+// derivedData holds data computed from the set values. It is built lazily on
+// first use and discarded by every operation that changes the set. Since the
+// values slice may be shared with callers through FromValues and Values, it is
+// checked against the values before being trusted
+type derivedData struct {
+	names []string
+	index map[string]int
 }
 
 // NewEnumSet creates a new empty EnumSet
@@ -19,6 +40,7 @@ func NewEnumSet[T any]() *EnumSet[T] {
 // Add appends an enum to the set
 func (s *EnumSet[T]) Add(e Enum[T]) {
 	s.values = append(s.values, e)
+	s.invalidate()
 }
 
 // Values returns all enums in the set
@@ -26,13 +48,22 @@ func (s *EnumSet[T]) Values() []Enum[T] {
 	return s.values
 }
 
+// Names returns the names of all enums in the set, in set order. The slice is
+// cached and shared between calls, so it must not be modified; a cache that no
+// longer matches the set is rebuilt
+func (s *EnumSet[T]) Names() []string {
+	d := s.derivedData()
+	if !s.namesMatch(d.names) {
+		d = s.rebuild()
+	}
+	return d.names
+}
+
 // FindByName searches for an enum by its name and returns an Optional containing
 // the enum if found, or an empty Optional if not found
 func (s *EnumSet[T]) FindByName(name string) optional.Optional[Enum[T]] {
-	for _, v := range s.values {
-		if v.Name == name {
-			return optional.Of(v)
-		}
+	if i, ok := s.position(name); ok {
+		return optional.Of(s.values[i])
 	}
 	return optional.Empty[Enum[T]]()
 }
@@ -43,9 +74,122 @@ func (s *EnumSet[T]) SortByOrder(getOrder func(T) int) *EnumSet[T] {
 	sort.SliceStable(s.values, func(i, j int) bool {
 		return getOrder(s.values[i].Value) < getOrder(s.values[j].Value)
 	})
+	s.invalidate()
 	return s
 }
 
+// ValuesFrom returns the enums from the one with the given name to the end of
+// the set, inclusive, following the current set order
+func (s *EnumSet[T]) ValuesFrom(name string) ([]Enum[T], error) {
+	from, err := s.indexOf(name)
+	if err != nil {
+		return nil, err
+	}
+	return s.slice(from, len(s.values)-1), nil
+}
+
+// ValuesUpTo returns the enums from the start of the set to the one with the
+// given name, inclusive, following the current set order
+func (s *EnumSet[T]) ValuesUpTo(name string) ([]Enum[T], error) {
+	to, err := s.indexOf(name)
+	if err != nil {
+		return nil, err
+	}
+	return s.slice(0, to), nil
+}
+
+// ValuesBetween returns the enums between the ones named from and to, both
+// inclusive, following the current set order. It returns an error if either
+// name is absent or if from comes after to
+func (s *EnumSet[T]) ValuesBetween(from, to string) ([]Enum[T], error) {
+	start, err := s.indexOf(from)
+	if err != nil {
+		return nil, err
+	}
+	end, err := s.indexOf(to)
+	if err != nil {
+		return nil, err
+	}
+	if start > end {
+		return nil, fmt.Errorf("%w: %s comes after %s", ErrInvalidRange, from, to)
+	}
+	return s.slice(start, end), nil
+}
+
+// indexOf returns the position of the first enum with the given name
+func (s *EnumSet[T]) indexOf(name string) (int, error) {
+	if i, ok := s.position(name); ok {
+		return i, nil
+	}
+	return -1, fmt.Errorf("%w: %s", ErrNameNotFound, name)
+}
+
+// position looks name up in the cached index, confirming the hit against the
+// set values. Names the cache doesn't know are searched linearly, and the cache
+// is rebuilt when the search finds one
+func (s *EnumSet[T]) position(name string) (int, bool) {
+	if i, ok := s.derivedData().index[name]; ok && i < len(s.values) && s.values[i].Name == name {
+		return i, true
+	}
+	for i, v := range s.values {
+		if v.Name == name {
+			s.rebuild()
+			return i, true
+		}
+	}
+	return -1, false
+}
+
+// derivedData returns the cached derived data, building it if needed. Concurrent
+// readers may build it more than once, but always store equivalent results
+func (s *EnumSet[T]) derivedData() *derivedData {
+	if d, _ := s.derived.Load().(*derivedData); d != nil {
+		return d
+	}
+	return s.rebuild()
+}
+
+// rebuild computes the derived data from the current set values and caches it
+func (s *EnumSet[T]) rebuild() *derivedData {
+	d := &derivedData{
+		names: make([]string, len(s.values)),
+		index: make(map[string]int, len(s.values)),
+	}
+	for i, v := range s.values {
+		d.names[i] = v.Name
+		if _, exists := d.index[v.Name]; !exists {
+			d.index[v.Name] = i
+		}
+	}
+	s.derived.Store(d)
+	return d
+}
+
+// namesMatch reports whether names still holds the names of the set values
+func (s *EnumSet[T]) namesMatch(names []string) bool {
+	if len(names) != len(s.values) {
+		return false
+	}
+	for i, v := range s.values {
+		if names[i] != v.Name {
+			return false
+		}
+	}
+	return true
+}
+
+// invalidate discards the cached derived data after the set changes
+func (s *EnumSet[T]) invalidate() {
+	s.derived.Store((*derivedData)(nil))
+}
+
+// slice returns a copy of the enums between the two indexes, inclusive
+func (s *EnumSet[T]) slice(from, to int) []Enum[T] {
+	result := make([]Enum[T], to-from+1)
+	copy(result, s.values[from:to+1])
+	return result
+}
+
 // FromValues creates a new EnumSet from a slice of Enum values
 func FromValues[T any](values []Enum[T]) *EnumSet[T] {
 	return &EnumSet[T]{values: values}
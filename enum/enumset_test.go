@@ -1,6 +1,8 @@
 package enum
 
 import (
+	"errors"
+	"sync"
 	"testing"
 )
 
@@ -198,3 +200,281 @@ func TestFromValues(t *testing.T) {
 		t.Errorf("FromValues() returned incorrect values")
 	}
 }
+
+// Severity is an ordered enum type used for range query tests
+type Severity int
+
+var severities = FromValues([]Enum[Severity]{
+	{Name: "ERROR", Value: 5},
+	{Name: "TRACE", Value: 1},
+	{Name: "WARN", Value: 4},
+	{Name: "DEBUG", Value: 2},
+	{Name: "INFO", Value: 3},
+}).SortByOrder(func(s Severity) int { return int(s) })
+
+func enumNames[T any](values []Enum[T]) []string {
+	names := make([]string, len(values))
+	for i, v := range values {
+		names[i] = v.Name
+	}
+	return names
+}
+
+func equalNames(got, want []string) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// TestEnumSetValuesFrom tests the ValuesFrom method of EnumSet
+func TestEnumSetValuesFrom(t *testing.T) {
+	tests := []struct {
+		name     string
+		from     string
+		expected []string
+		err      error
+	}{
+		{"From first", "TRACE", []string{"TRACE", "DEBUG", "INFO", "WARN", "ERROR"}, nil},
+		{"From middle", "WARN", []string{"WARN", "ERROR"}, nil},
+		{"From last", "ERROR", []string{"ERROR"}, nil},
+		{"Unknown name", "FATAL", nil, ErrNameNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values, err := severities.ValuesFrom(tt.from)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("ValuesFrom(%v) error = %v, want %v", tt.from, err, tt.err)
+			}
+			if got := enumNames(values); !equalNames(got, tt.expected) {
+				t.Errorf("ValuesFrom(%v) = %v, want %v", tt.from, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestEnumSetValuesUpTo tests the ValuesUpTo method of EnumSet
+func TestEnumSetValuesUpTo(t *testing.T) {
+	tests := []struct {
+		name     string
+		to       string
+		expected []string
+		err      error
+	}{
+		{"Up to first", "TRACE", []string{"TRACE"}, nil},
+		{"Up to middle", "INFO", []string{"TRACE", "DEBUG", "INFO"}, nil},
+		{"Up to last", "ERROR", []string{"TRACE", "DEBUG", "INFO", "WARN", "ERROR"}, nil},
+		{"Unknown name", "FATAL", nil, ErrNameNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values, err := severities.ValuesUpTo(tt.to)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("ValuesUpTo(%v) error = %v, want %v", tt.to, err, tt.err)
+			}
+			if got := enumNames(values); !equalNames(got, tt.expected) {
+				t.Errorf("ValuesUpTo(%v) = %v, want %v", tt.to, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestEnumSetValuesBetween tests the ValuesBetween method of EnumSet
+func TestEnumSetValuesBetween(t *testing.T) {
+	tests := []struct {
+		name     string
+		from     string
+		to       string
+		expected []string
+		err      error
+	}{
+		{"Inclusive boundaries", "DEBUG", "WARN", []string{"DEBUG", "INFO", "WARN"}, nil},
+		{"Whole set", "TRACE", "ERROR", []string{"TRACE", "DEBUG", "INFO", "WARN", "ERROR"}, nil},
+		{"Single element", "INFO", "INFO", []string{"INFO"}, nil},
+		{"Reversed arguments", "WARN", "DEBUG", nil, ErrInvalidRange},
+		{"Unknown from", "FATAL", "ERROR", nil, ErrNameNotFound},
+		{"Unknown to", "TRACE", "FATAL", nil, ErrNameNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values, err := severities.ValuesBetween(tt.from, tt.to)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("ValuesBetween(%v, %v) error = %v, want %v", tt.from, tt.to, err, tt.err)
+			}
+			if got := enumNames(values); !equalNames(got, tt.expected) {
+				t.Errorf("ValuesBetween(%v, %v) = %v, want %v", tt.from, tt.to, got, tt.expected)
+			}
+		})
+	}
+
+	// The returned slice is a copy and doesn't alias the set
+	values, _ := severities.ValuesBetween("TRACE", "DEBUG")
+	values[0] = Enum[Severity]{Name: "CHANGED"}
+	if severities.Values()[0].Name != "TRACE" {
+		t.Errorf("ValuesBetween() result aliases the set values")
+	}
+}
+
+// TestEnumSetNames tests the Names method of EnumSet
+func TestEnumSetNames(t *testing.T) {
+	set := FromValues([]Enum[TestEnum]{TestSecond, TestFirst})
+
+	if got := set.Names(); !equalNames(got, []string{"SECOND", "FIRST"}) {
+		t.Errorf("EnumSet.Names() = %v, want [SECOND FIRST]", got)
+	}
+
+	if got := NewEnumSet[TestEnum]().Names(); len(got) != 0 {
+		t.Errorf("EnumSet.Names() on empty set = %v, want []", got)
+	}
+}
+
+// TestEnumSetCacheInvalidation tests that mutating operations refresh derived data
+func TestEnumSetCacheInvalidation(t *testing.T) {
+	set := NewEnumSet[TestEnum]()
+	set.Add(TestThird)
+	set.Add(TestFirst)
+
+	if set.FindByName("SECOND").IsPresent() {
+		t.Errorf("EnumSet.FindByName(SECOND) found before being added")
+	}
+	if got := set.Names(); !equalNames(got, []string{"THIRD", "FIRST"}) {
+		t.Errorf("EnumSet.Names() = %v, want [THIRD FIRST]", got)
+	}
+
+	set.Add(TestSecond)
+	if !set.FindByName("SECOND").IsPresent() {
+		t.Errorf("EnumSet.FindByName(SECOND) not found after Add")
+	}
+	if got := set.Names(); !equalNames(got, []string{"THIRD", "FIRST", "SECOND"}) {
+		t.Errorf("EnumSet.Names() after Add = %v, want [THIRD FIRST SECOND]", got)
+	}
+
+	set.SortByOrder(func(e TestEnum) int { return int(e) })
+	if got := set.Names(); !equalNames(got, []string{"FIRST", "SECOND", "THIRD"}) {
+		t.Errorf("EnumSet.Names() after SortByOrder = %v, want [FIRST SECOND THIRD]", got)
+	}
+	values, err := set.ValuesUpTo("SECOND")
+	if err != nil || !equalNames(enumNames(values), []string{"FIRST", "SECOND"}) {
+		t.Errorf("EnumSet.ValuesUpTo(SECOND) after SortByOrder = %v, %v", enumNames(values), err)
+	}
+}
+
+// TestEnumSetDuplicateNames tests that the first enum wins when names repeat
+func TestEnumSetDuplicateNames(t *testing.T) {
+	set := FromValues([]Enum[TestEnum]{TestFirst, {Name: "FIRST", Value: THIRD}})
+
+	if found, _ := set.FindByName("FIRST").GetIfPresent(); found.Value != FIRST {
+		t.Errorf("EnumSet.FindByName(FIRST).Value = %v, want %v", found.Value, FIRST)
+	}
+}
+
+// TestEnumSetConcurrentReaders tests concurrent reads of a set that isn't modified
+func TestEnumSetConcurrentReaders(t *testing.T) {
+	set := FromValues([]Enum[TestEnum]{TestFirst, TestSecond, TestThird})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if len(set.Names()) != 3 || !set.FindByName("THIRD").IsPresent() {
+				t.Errorf("concurrent read returned inconsistent data")
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// BenchmarkEnumSetNames measures repeated Names calls on a stable set
+func BenchmarkEnumSetNames(b *testing.B) {
+	set := FromValues([]Enum[TestEnum]{TestFirst, TestSecond, TestThird})
+	b.ReportAllocs()
+	for b.Loop() {
+		_ = set.Names()
+	}
+}
+
+// BenchmarkEnumSetFindByName measures repeated lookups on a stable set
+func BenchmarkEnumSetFindByName(b *testing.B) {
+	set := FromValues([]Enum[TestEnum]{TestFirst, TestSecond, TestThird})
+	b.ReportAllocs()
+	for b.Loop() {
+		_ = set.FindByName("THIRD")
+	}
+}
+
+// TestEnumSetAliasedSlices tests that lookups follow changes made through the
+// slices shared with the set by FromValues and Values
+func TestEnumSetAliasedSlices(t *testing.T) {
+	vals := []Enum[TestEnum]{TestFirst, TestSecond}
+	set := FromValues(vals)
+	set.FindByName("FIRST")
+
+	vals[0] = TestThird
+	if set.FindByName("FIRST").IsPresent() {
+		t.Errorf("FindByName(FIRST) found an enum replaced in the source slice")
+	}
+	if found, ok := set.FindByName("THIRD").GetIfPresent(); !ok || found.Name != "THIRD" {
+		t.Errorf("FindByName(THIRD) after changing the source slice = %v, %v", found, ok)
+	}
+
+	values := set.Values()
+	values[1] = TestFirst
+	if set.FindByName("SECOND").IsPresent() {
+		t.Errorf("FindByName(SECOND) found an enum replaced through Values()")
+	}
+	if got := set.Names(); !equalNames(got, []string{"THIRD", "FIRST"}) {
+		t.Errorf("Names() after changing shared slices = %v, want [THIRD FIRST]", got)
+	}
+	got, err := set.ValuesUpTo("FIRST")
+	if err != nil || !equalNames(enumNames(got), []string{"THIRD", "FIRST"}) {
+		t.Errorf("ValuesUpTo(FIRST) after changing shared slices = %v, %v", enumNames(got), err)
+	}
+
+	set.SortByOrder(func(e TestEnum) int { return int(e) })
+	if !equalNames(enumNames(vals), []string{"FIRST", "THIRD"}) {
+		t.Errorf("source slice after SortByOrder = %v, want [FIRST THIRD]", enumNames(vals))
+	}
+}
+
+// BenchmarkEnumSetValues measures repeated Values calls on a stable set
+func BenchmarkEnumSetValues(b *testing.B) {
+	set := FromValues([]Enum[TestEnum]{TestFirst, TestSecond, TestThird})
+	b.ReportAllocs()
+	for b.Loop() {
+		_ = set.Values()
+	}
+}
+
+// TestEnumSetNamesWrite tests that writing into the slice returned by Names
+// doesn't break later Names calls or the checks built on the set
+func TestEnumSetNamesWrite(t *testing.T) {
+	set := FromValues([]Enum[TestEnum]{TestFirst, TestSecond})
+	set.Names()[0] = "BOGUS"
+
+	if got := set.Names(); !equalNames(got, []string{"FIRST", "SECOND"}) {
+		t.Errorf("EnumSet.Names() after writing into it = %v, want [FIRST SECOND]", got)
+	}
+	if !set.FindByName("FIRST").IsPresent() {
+		t.Errorf("EnumSet.FindByName(FIRST) not found after writing into Names()")
+	}
+
+	set.Names()[1] = "BOGUS"
+	m := NewEnumMap[TestEnum, int](set)
+	m.Put("FIRST", 1)
+	m.Put("SECOND", 2)
+	if err := m.MustComplete(); err != nil {
+		t.Errorf("EnumMap.MustComplete() after writing into Names() = %v, want nil", err)
+	}
+	if err := set.CheckStyle(SnakeCase); err != nil {
+		t.Errorf("EnumSet.CheckStyle() after writing into Names() = %v, want nil", err)
+	}
+}
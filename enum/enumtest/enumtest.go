@@ -0,0 +1,108 @@
+// Package enumtest provides assertion helpers for tests of code built on the
+// enum package.
+package enumtest
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/tiagods/go-extras/enum"
+)
+
+// AssertMembers fails the test unless the set contains exactly the given names,
+// in any order. Missing and unexpected names are both reported
+func AssertMembers[T any](t testing.TB, set *enum.EnumSet[T], names ...string) {
+	t.Helper()
+
+	expected := make(map[string]bool, len(names))
+	for _, name := range names {
+		expected[name] = true
+	}
+
+	actual := make(map[string]bool)
+	var unexpected []string
+	for _, e := range set.Values() {
+		actual[e.Name] = true
+		if !expected[e.Name] {
+			unexpected = append(unexpected, e.Name)
+		}
+	}
+
+	var missing []string
+	for _, name := range names {
+		if !actual[name] {
+			missing = append(missing, name)
+		}
+	}
+
+	if len(missing) > 0 {
+		t.Errorf("enum set is missing names: %s", strings.Join(missing, ", "))
+	}
+	if len(unexpected) > 0 {
+		t.Errorf("enum set has unexpected names: %s", strings.Join(unexpected, ", "))
+	}
+}
+
+// AssertRoundTripJSON fails the test if any member of the set does not survive
+// a JSON round trip: marshaling it and resolving the decoded name through the
+// set must yield that same member, not another one sharing its name
+func AssertRoundTripJSON[T any](t testing.TB, set *enum.EnumSet[T]) {
+	t.Helper()
+
+	values := set.Values()
+	for i, e := range values {
+		data, err := json.Marshal(e)
+		if err != nil {
+			t.Errorf("json.Marshal(%s) error = %v", e.Name, err)
+			continue
+		}
+
+		var name string
+		if err := json.Unmarshal(data, &name); err != nil {
+			t.Errorf("json.Unmarshal(%s) error = %v", data, err)
+			continue
+		}
+
+		// FindByName resolves to the first member with the name, so the
+		// member round-trips only if it is that first match
+		match := -1
+		for j, candidate := range values {
+			if candidate.Name == name {
+				match = j
+				break
+			}
+		}
+		switch {
+		case match < 0:
+			t.Errorf("enum %s marshaled to %s which is not found in the set", e.Name, data)
+		case match != i:
+			t.Errorf("enum %s at index %d round-tripped to the member at index %d", e.Name, i, match)
+		}
+	}
+}
+
+// Exhaustive fails the test listing every member of the set whose name is not
+// in handled. Use it to guard switches and lookup tables over enum names so
+// that adding a new enum value without handling it breaks the build's tests
+func Exhaustive[T any](t testing.TB, set *enum.EnumSet[T], handled []string) {
+	t.Helper()
+
+	covered := make(map[string]bool, len(handled))
+	for _, name := range handled {
+		covered[name] = true
+	}
+
+	var unhandled []string
+	for _, e := range set.Values() {
+		if !covered[e.Name] {
+			unhandled = append(unhandled, e.Name)
+		}
+	}
+
+	if len(unhandled) > 0 {
+		sort.Strings(unhandled)
+		t.Errorf("unhandled enum names: %s", strings.Join(unhandled, ", "))
+	}
+}
@@ -0,0 +1,110 @@
+package enumtest
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/tiagods/go-extras/enum"
+)
+
+// recordingTB captures failures instead of reporting them to the real test
+type recordingTB struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recordingTB) Helper() {}
+
+func (r *recordingTB) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+type Level int
+
+var (
+	LOW    = enum.Enum[Level]{Name: "LOW", Value: 1}
+	MEDIUM = enum.Enum[Level]{Name: "MEDIUM", Value: 2}
+	HIGH   = enum.Enum[Level]{Name: "HIGH", Value: 3}
+)
+
+func levels() *enum.EnumSet[Level] {
+	return enum.FromValues([]enum.Enum[Level]{LOW, MEDIUM, HIGH})
+}
+
+func TestAssertMembers(t *testing.T) {
+	tests := []struct {
+		name       string
+		names      []string
+		wantErrors []string
+	}{
+		{"Exact members", []string{"LOW", "MEDIUM", "HIGH"}, nil},
+		{"Exact members in another order", []string{"HIGH", "LOW", "MEDIUM"}, nil},
+		{"Missing name", []string{"LOW", "MEDIUM", "HIGH", "CRITICAL"}, []string{"missing names: CRITICAL"}},
+		{"Unexpected name", []string{"LOW", "MEDIUM"}, []string{"unexpected names: HIGH"}},
+		{"Missing and unexpected", []string{"LOW", "MEDIUM", "URGENT"}, []string{"missing names: URGENT", "unexpected names: HIGH"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := &recordingTB{TB: t}
+			AssertMembers(rec, levels(), tt.names...)
+			assertRecorded(t, rec, tt.wantErrors)
+		})
+	}
+}
+
+func TestAssertRoundTripJSON(t *testing.T) {
+	rec := &recordingTB{TB: t}
+	AssertRoundTripJSON(rec, levels())
+	assertRecorded(t, rec, nil)
+
+	// A set with an empty-named member still round-trips, since the name is
+	// the serialized form
+	rec = &recordingTB{TB: t}
+	AssertRoundTripJSON(rec, enum.FromValues([]enum.Enum[Level]{{Name: "", Value: 0}, LOW}))
+	assertRecorded(t, rec, nil)
+
+	// A duplicate name makes the second member decode to the first one
+	rec = &recordingTB{TB: t}
+	AssertRoundTripJSON(rec, enum.FromValues([]enum.Enum[Level]{LOW, MEDIUM, {Name: "LOW", Value: 4}}))
+	assertRecorded(t, rec, []string{"enum LOW at index 2 round-tripped to the member at index 0"})
+
+	// Invalid UTF-8 is replaced when marshaling, so the name is not found
+	rec = &recordingTB{TB: t}
+	AssertRoundTripJSON(rec, enum.FromValues([]enum.Enum[Level]{{Name: "BAD\xff", Value: 5}}))
+	assertRecorded(t, rec, []string{"which is not found in the set"})
+}
+
+func TestExhaustive(t *testing.T) {
+	tests := []struct {
+		name       string
+		handled    []string
+		wantErrors []string
+	}{
+		{"All handled", []string{"LOW", "MEDIUM", "HIGH"}, nil},
+		{"Extra handled names are ignored", []string{"LOW", "MEDIUM", "HIGH", "LEGACY"}, nil},
+		{"One unhandled", []string{"LOW", "HIGH"}, []string{"unhandled enum names: MEDIUM"}},
+		{"Nothing handled", nil, []string{"unhandled enum names: HIGH, LOW, MEDIUM"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := &recordingTB{TB: t}
+			Exhaustive(rec, levels(), tt.handled)
+			assertRecorded(t, rec, tt.wantErrors)
+		})
+	}
+}
+
+func assertRecorded(t *testing.T, rec *recordingTB, want []string) {
+	t.Helper()
+	if len(rec.errors) != len(want) {
+		t.Fatalf("recorded errors = %q, want %d errors matching %q", rec.errors, len(want), want)
+	}
+	for i, w := range want {
+		if !strings.Contains(rec.errors[i], w) {
+			t.Errorf("recorded error[%d] = %q, want it to contain %q", i, rec.errors[i], w)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package enum_test
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/tiagods/go-extras/enum"
+)
+
+type OperationValue struct {
+	Symbol string
+	Order  int
+	Apply  func(a, b float64) float64
+}
+
+var (
+	SUM = enum.Enum[OperationValue]{
+		Name:  "SUM",
+		Value: OperationValue{Symbol: "+", Order: 2, Apply: func(a, b float64) float64 { return a + b }},
+	}
+	SUBTRACT = enum.Enum[OperationValue]{
+		Name:  "SUBTRACT",
+		Value: OperationValue{Symbol: "-", Order: 3, Apply: func(a, b float64) float64 { return a - b }},
+	}
+	MULTIPLY = enum.Enum[OperationValue]{
+		Name:  "MULTIPLY",
+		Value: OperationValue{Symbol: "*", Order: 4, Apply: func(a, b float64) float64 { return a * b }},
+	}
+	MODULUS = enum.Enum[OperationValue]{
+		Name:  "MODULUS",
+		Value: OperationValue{Symbol: "%", Order: 1, Apply: math.Mod},
+	}
+)
+
+func ExampleEnum_String() {
+	fmt.Println(SUM.String())
+	fmt.Printf("%v\n", MULTIPLY)
+	// Output:
+	// SUM
+	// MULTIPLY
+}
+
+func ExampleEnum_Equal() {
+	fmt.Println(SUM.Equal(SUM))
+	fmt.Println(SUM.Equal(SUBTRACT))
+	// Output:
+	// true
+	// false
+}
+
+func ExampleNewEnumSet() {
+	set := enum.NewEnumSet[OperationValue]()
+	set.Add(SUM)
+	set.Add(MULTIPLY)
+	fmt.Println(set.Names())
+	// Output: [SUM MULTIPLY]
+}
+
+func ExampleEnumSet_FindByName() {
+	operations := enum.FromValues([]enum.Enum[OperationValue]{SUM, SUBTRACT, MULTIPLY, MODULUS})
+
+	for _, name := range []string{"SUM", "MODULUS", "POWER"} {
+		if op, found := operations.FindByName(name).GetIfPresent(); found {
+			fmt.Printf("10 %s 3 = %v\n", op.Value.Symbol, op.Value.Apply(10, 3))
+		} else {
+			fmt.Printf("operation %s not found\n", name)
+		}
+	}
+	// Output:
+	// 10 + 3 = 13
+	// 10 % 3 = 1
+	// operation POWER not found
+}
+
+func ExampleEnumSet_SortByOrder() {
+	operations := enum.FromValues([]enum.Enum[OperationValue]{SUM, SUBTRACT, MULTIPLY, MODULUS}).
+		SortByOrder(func(op OperationValue) int { return op.Order })
+
+	for _, op := range operations.Values() {
+		fmt.Printf("%d %s %s\n", op.Value.Order, op.Name, op.Value.Symbol)
+	}
+	// Output:
+	// 1 MODULUS %
+	// 2 SUM +
+	// 3 SUBTRACT -
+	// 4 MULTIPLY *
+}
+
+func ExampleEnumSet_ValuesFrom() {
+	operations := enum.FromValues([]enum.Enum[OperationValue]{SUM, SUBTRACT, MULTIPLY, MODULUS}).
+		SortByOrder(func(op OperationValue) int { return op.Order })
+
+	values, err := operations.ValuesFrom("SUBTRACT")
+	for _, op := range values {
+		fmt.Println(op.Name)
+	}
+	fmt.Println(err)
+	// Output:
+	// SUBTRACT
+	// MULTIPLY
+	// <nil>
+}
+
+func ExampleSumBy() {
+	operations := enum.FromValues([]enum.Enum[OperationValue]{SUM, SUBTRACT, MULTIPLY})
+	fmt.Println(enum.SumBy(operations, func(op OperationValue) int { return op.Order }))
+	// Output: 9
+}
+
+func ExampleDecodeSlice() {
+	operations := enum.FromValues([]enum.Enum[OperationValue]{SUM, SUBTRACT, MULTIPLY})
+
+	_, err := enum.DecodeSlice(operations, []byte(`["SUM","POWERR","MULTIPLY"]`))
+	fmt.Println(err)
+	// Output: invalid value at index 1: enum name not found: POWERR
+}
+
+func ExampleEnumMap_MustComplete() {
+	operations := enum.FromValues([]enum.Enum[OperationValue]{SUM, SUBTRACT, MULTIPLY})
+
+	descriptions := enum.NewEnumMap[OperationValue, string](operations)
+	descriptions.Put("SUM", "Adds two values")
+	descriptions.Put("MULTIPLY", "Multiplies two values")
+	fmt.Println(descriptions.MustComplete())
+	// Output: enum map is incomplete: missing SUBTRACT
+}
@@ -0,0 +1,25 @@
+package enum
+
+import "github.com/tiagods/go-extras/optional"
+
+// Number is a constraint that permits any integer or floating-point type. It is
+// an alias of optional.Number so both packages share one definition
+type Number = optional.Number
+
+// FoldValues combines the values of all enums in the set, in set order,
+// starting from initial and applying fn to the accumulator and each value
+func FoldValues[T any, R any](s *EnumSet[T], initial R, fn func(R, T) R) R {
+	result := initial
+	for _, v := range s.values {
+		result = fn(result, v.Value)
+	}
+	return result
+}
+
+// SumBy returns the sum of the numeric property extracted by f from the value
+// of each enum in the set
+func SumBy[T any, N Number](s *EnumSet[T], f func(T) N) N {
+	return FoldValues(s, N(0), func(total N, value T) N {
+		return total + f(value)
+	})
+}
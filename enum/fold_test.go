@@ -0,0 +1,76 @@
+package enum
+
+import (
+	"strings"
+	"testing"
+)
+
+// TaxValue is an enum payload with numeric properties
+type TaxValue struct {
+	Symbol string
+	Rate   float64
+	Weight int
+}
+
+var taxes = FromValues([]Enum[TaxValue]{
+	{Name: "FEDERAL", Value: TaxValue{Symbol: "F", Rate: 0.15, Weight: 3}},
+	{Name: "STATE", Value: TaxValue{Symbol: "S", Rate: 0.05, Weight: 2}},
+	{Name: "CITY", Value: TaxValue{Symbol: "C", Rate: 0.01, Weight: 1}},
+})
+
+// TestFoldValues tests the FoldValues function
+func TestFoldValues(t *testing.T) {
+	symbols := FoldValues(taxes, "", func(acc string, v TaxValue) string {
+		return acc + v.Symbol
+	})
+	if symbols != "FSC" {
+		t.Errorf("FoldValues() = %v, want FSC", symbols)
+	}
+
+	maxWeight := FoldValues(taxes, 0, func(acc int, v TaxValue) int {
+		return max(acc, v.Weight)
+	})
+	if maxWeight != 3 {
+		t.Errorf("FoldValues() max weight = %v, want 3", maxWeight)
+	}
+
+	empty := FoldValues(NewEnumSet[TaxValue](), "initial", func(acc string, v TaxValue) string {
+		t.Errorf("FoldValues() called fn on an empty set")
+		return acc
+	})
+	if empty != "initial" {
+		t.Errorf("FoldValues() on empty set = %v, want initial", empty)
+	}
+}
+
+// TestFoldValuesOrder tests that FoldValues follows the set order
+func TestFoldValuesOrder(t *testing.T) {
+	set := FromValues([]Enum[TaxValue]{
+		{Name: "A", Value: TaxValue{Symbol: "a", Weight: 2}},
+		{Name: "B", Value: TaxValue{Symbol: "b", Weight: 1}},
+	}).SortByOrder(func(v TaxValue) int { return v.Weight })
+
+	joined := FoldValues(set, []string{}, func(acc []string, v TaxValue) []string {
+		return append(acc, v.Symbol)
+	})
+	if got := strings.Join(joined, ","); got != "b,a" {
+		t.Errorf("FoldValues() = %v, want b,a", got)
+	}
+}
+
+// TestSumBy tests the SumBy function
+func TestSumBy(t *testing.T) {
+	totalWeight := SumBy(taxes, func(v TaxValue) int { return v.Weight })
+	if totalWeight != 6 {
+		t.Errorf("SumBy() weight = %v, want 6", totalWeight)
+	}
+
+	totalRate := SumBy(taxes, func(v TaxValue) float64 { return v.Rate })
+	if totalRate < 0.2099 || totalRate > 0.2101 {
+		t.Errorf("SumBy() rate = %v, want 0.21", totalRate)
+	}
+
+	if got := SumBy(NewEnumSet[TaxValue](), func(v TaxValue) int { return v.Weight }); got != 0 {
+		t.Errorf("SumBy() on empty set = %v, want 0", got)
+	}
+}
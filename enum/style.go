@@ -0,0 +1,150 @@
+package enum
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/tiagods/go-extras/optional"
+)
+
+// NameStyle identifies a casing convention that canonical enum names, written
+// in SCREAMING_SNAKE_CASE, can be converted to
+type NameStyle int
+
+const (
+	// SnakeCase renders HTTP_SERVER as http_server
+	SnakeCase NameStyle = iota
+	// KebabCase renders HTTP_SERVER as http-server
+	KebabCase
+	// CamelCase renders HTTP_SERVER as httpServer
+	CamelCase
+	// PascalCase renders HTTP_SERVER as HttpServer
+	PascalCase
+)
+
+// String returns the name of the style
+func (s NameStyle) String() string {
+	switch s {
+	case SnakeCase:
+		return "snake_case"
+	case KebabCase:
+		return "kebab-case"
+	case CamelCase:
+		return "camelCase"
+	case PascalCase:
+		return "PascalCase"
+	}
+	return fmt.Sprintf("NameStyle(%d)", int(s))
+}
+
+// Format converts a canonical name to the style. Words are separated by
+// underscores in the canonical name, and acronyms are treated as regular
+// words, so HTTP_SERVER becomes httpServer rather than hTTPServer. The
+// conversion is lossy: case and repeated underscores are not preserved, so
+// distinct names such as A_B and A__B can format to the same value
+func (s NameStyle) Format(name string) string {
+	words := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool { return r == '_' })
+
+	switch s {
+	case KebabCase:
+		return strings.Join(words, "-")
+	case CamelCase, PascalCase:
+		var b strings.Builder
+		for i, w := range words {
+			if i > 0 || s == PascalCase {
+				w = capitalize(w)
+			}
+			b.WriteString(w)
+		}
+		return b.String()
+	}
+	return strings.Join(words, "_")
+}
+
+// capitalize upper-cases the first rune of a word
+func capitalize(w string) string {
+	r, size := utf8.DecodeRuneInString(w)
+	return string(unicode.ToUpper(r)) + w[size:]
+}
+
+// CheckStyle returns an error listing the names of the set that format to the
+// same value in the given style, or nil if every styled name round-trips
+func (s *EnumSet[T]) CheckStyle(style NameStyle) error {
+	collisions := s.styleCollisions(style)
+	if len(collisions) == 0 {
+		return nil
+	}
+
+	descriptions := make([]string, 0, len(collisions))
+	for styled, names := range collisions {
+		descriptions = append(descriptions, fmt.Sprintf("%s from %s", styled, strings.Join(names, ", ")))
+	}
+	sort.Strings(descriptions)
+	return fmt.Errorf("%w in %v: %s", ErrAmbiguousName, style, strings.Join(descriptions, "; "))
+}
+
+// NameAs returns the name of the enum converted to the given style. It returns
+// an error if the name is not part of the set, or if another name of the set
+// formats to the same value, since the result couldn't be resolved back
+func (s *EnumSet[T]) NameAs(style NameStyle, name string) (string, error) {
+	if !s.FindByName(name).IsPresent() {
+		return "", fmt.Errorf("%w: %s", ErrNameNotFound, name)
+	}
+	styled := style.Format(name)
+	if names, ambiguous := s.styleCollisions(style)[styled]; ambiguous {
+		return "", fmt.Errorf("%w in %v: %s from %s", ErrAmbiguousName, style, styled, strings.Join(names, ", "))
+	}
+	return styled, nil
+}
+
+// FindByStyledName searches for the enum whose name, converted to the given
+// style, equals value. It is the inverse of NameAs, and returns an empty
+// Optional when value matches more than one distinct name
+func (s *EnumSet[T]) FindByStyledName(style NameStyle, value string) optional.Optional[Enum[T]] {
+	result := optional.Empty[Enum[T]]()
+	for _, v := range s.values {
+		if style.Format(v.Name) != value {
+			continue
+		}
+		if match, found := result.GetIfPresent(); found {
+			if match.Name != v.Name {
+				return optional.Empty[Enum[T]]()
+			}
+			continue
+		}
+		result = optional.Of(v)
+	}
+	return result
+}
+
+// styleCollisions maps every styled value produced by more than one distinct
+// name of the set to those names, in set order
+func (s *EnumSet[T]) styleCollisions(style NameStyle) map[string][]string {
+	byStyled := map[string][]string{}
+	for _, v := range s.values {
+		styled := style.Format(v.Name)
+		if !containsName(byStyled[styled], v.Name) {
+			byStyled[styled] = append(byStyled[styled], v.Name)
+		}
+	}
+
+	collisions := map[string][]string{}
+	for styled, names := range byStyled {
+		if len(names) > 1 {
+			collisions[styled] = names
+		}
+	}
+	return collisions
+}
+
+func containsName(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,139 @@
+package enum
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestNameStyleFormat tests converting canonical names to each style
+func TestNameStyleFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		style    NameStyle
+		expected string
+	}{
+		{"Snake single word", "SUM", SnakeCase, "sum"},
+		{"Snake acronym", "HTTP_SERVER", SnakeCase, "http_server"},
+		{"Kebab acronym", "HTTP_SERVER", KebabCase, "http-server"},
+		{"Camel acronym", "HTTP_SERVER", CamelCase, "httpServer"},
+		{"Pascal acronym", "HTTP_SERVER", PascalCase, "HttpServer"},
+		{"Camel single word", "SUM", CamelCase, "sum"},
+		{"Pascal single word", "SUM", PascalCase, "Sum"},
+		{"Camel three words", "MAX_RETRY_COUNT", CamelCase, "maxRetryCount"},
+		{"Pascal with digits", "LEVEL_2_CACHE", PascalCase, "Level2Cache"},
+		{"Pascal non-ASCII first letter", "ÉCOLE_NAME", PascalCase, "ÉcoleName"},
+		{"Camel non-ASCII word", "HTTP_ÉCOLE", CamelCase, "httpÉcole"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.style.Format(tt.input); got != tt.expected {
+				t.Errorf("%v.Format(%v) = %v, want %v", tt.style, tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestNameStyleString tests the String method of NameStyle
+func TestNameStyleString(t *testing.T) {
+	if SnakeCase.String() != "snake_case" || PascalCase.String() != "PascalCase" {
+		t.Errorf("NameStyle.String() returned unexpected names")
+	}
+	if got := NameStyle(42).String(); got != "NameStyle(42)" {
+		t.Errorf("NameStyle(42).String() = %v, want NameStyle(42)", got)
+	}
+}
+
+// TestEnumSetNameAs tests the NameAs method of EnumSet
+func TestEnumSetNameAs(t *testing.T) {
+	servers := FromValues([]Enum[int]{{Name: "HTTP_SERVER", Value: 1}, {Name: "GRPC_SERVER", Value: 2}})
+
+	styled, err := servers.NameAs(KebabCase, "HTTP_SERVER")
+	if err != nil || styled != "http-server" {
+		t.Errorf("NameAs(KebabCase, HTTP_SERVER) = %v, %v, want http-server", styled, err)
+	}
+
+	if _, err := servers.NameAs(KebabCase, "FTP_SERVER"); !errors.Is(err, ErrNameNotFound) {
+		t.Errorf("NameAs(KebabCase, FTP_SERVER) error = %v, want %v", err, ErrNameNotFound)
+	}
+}
+
+// TestEnumSetFindByStyledName tests that styled names round-trip through the set
+func TestEnumSetFindByStyledName(t *testing.T) {
+	servers := FromValues([]Enum[int]{
+		{Name: "HTTP_SERVER", Value: 1},
+		{Name: "GRPC_SERVER", Value: 2},
+		{Name: "SMTP_RELAY_SERVER", Value: 3},
+	})
+
+	for _, style := range []NameStyle{SnakeCase, KebabCase, CamelCase, PascalCase} {
+		for _, e := range servers.Values() {
+			styled, err := servers.NameAs(style, e.Name)
+			if err != nil {
+				t.Fatalf("NameAs(%v, %v) error = %v", style, e.Name, err)
+			}
+			found, ok := servers.FindByStyledName(style, styled).GetIfPresent()
+			if !ok || !found.Equal(e) {
+				t.Errorf("FindByStyledName(%v, %v) = %v, %v, want %v", style, styled, found, ok, e)
+			}
+		}
+	}
+
+	if servers.FindByStyledName(CamelCase, "HttpServer").IsPresent() {
+		t.Errorf("FindByStyledName(CamelCase, HttpServer) should not match a Pascal name")
+	}
+}
+
+// TestEnumSetStyleCollisions tests that names formatting to the same styled
+// value are detected instead of silently resolving to the first one
+func TestEnumSetStyleCollisions(t *testing.T) {
+	set := FromValues([]Enum[int]{
+		{Name: "A_B", Value: 1},
+		{Name: "A__B", Value: 2},
+		{Name: "HTTPSERVER", Value: 3},
+		{Name: "HttpServer", Value: 4},
+		{Name: "HTTP_SERVER", Value: 5},
+	})
+
+	err := set.CheckStyle(SnakeCase)
+	if !errors.Is(err, ErrAmbiguousName) {
+		t.Fatalf("CheckStyle(SnakeCase) error = %v, want %v", err, ErrAmbiguousName)
+	}
+	want := "ambiguous styled enum name in snake_case: a_b from A_B, A__B; httpserver from HTTPSERVER, HttpServer"
+	if err.Error() != want {
+		t.Errorf("CheckStyle(SnakeCase) error = %q, want %q", err, want)
+	}
+
+	if _, err := set.NameAs(KebabCase, "A__B"); !errors.Is(err, ErrAmbiguousName) {
+		t.Errorf("NameAs(KebabCase, A__B) error = %v, want %v", err, ErrAmbiguousName)
+	}
+	if styled, err := set.NameAs(KebabCase, "HTTP_SERVER"); err != nil || styled != "http-server" {
+		t.Errorf("NameAs(KebabCase, HTTP_SERVER) = %v, %v, want http-server", styled, err)
+	}
+
+	if set.FindByStyledName(KebabCase, "a-b").IsPresent() {
+		t.Errorf("FindByStyledName(KebabCase, a-b) should refuse an ambiguous value")
+	}
+	if set.FindByStyledName(PascalCase, "Httpserver").IsPresent() {
+		t.Errorf("FindByStyledName(PascalCase, Httpserver) should refuse an ambiguous value")
+	}
+	if found, ok := set.FindByStyledName(CamelCase, "httpServer").GetIfPresent(); !ok || found.Name != "HTTP_SERVER" {
+		t.Errorf("FindByStyledName(CamelCase, httpServer) = %v, %v, want HTTP_SERVER", found, ok)
+	}
+}
+
+// TestEnumSetCheckStyle tests that sets without collisions pass the check
+func TestEnumSetCheckStyle(t *testing.T) {
+	// Repeating the same name is not a styling collision
+	set := FromValues([]Enum[int]{{Name: "HTTP_SERVER", Value: 1}, {Name: "GRPC_SERVER", Value: 2}, {Name: "HTTP_SERVER", Value: 3}})
+
+	for _, style := range []NameStyle{SnakeCase, KebabCase, CamelCase, PascalCase} {
+		if err := set.CheckStyle(style); err != nil {
+			t.Errorf("CheckStyle(%v) error = %v, want nil", style, err)
+		}
+	}
+	if found, ok := set.FindByStyledName(KebabCase, "http-server").GetIfPresent(); !ok || found.Value != 1 {
+		t.Errorf("FindByStyledName(KebabCase, http-server) = %v, %v, want the first HTTP_SERVER", found, ok)
+	}
+}
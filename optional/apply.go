@@ -0,0 +1,27 @@
+package optional
+
+// ApplyIfPresent writes the value of o through dst when it is present and
+// reports whether it wrote. It panics if dst is nil, since that is always a
+// programming error
+func ApplyIfPresent[T any](o Optional[T], dst *T) bool {
+	if dst == nil {
+		panic("optional: ApplyIfPresent called with nil destination")
+	}
+	if !o.found {
+		return false
+	}
+	*dst = o.value
+	return true
+}
+
+// CollectChanged returns how many of the given ApplyIfPresent results wrote a
+// value, which tells whether a merge changed anything
+func CollectChanged(applied ...bool) int {
+	count := 0
+	for _, a := range applied {
+		if a {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,68 @@
+package optional
+
+import "testing"
+
+func TestApplyIfPresent(t *testing.T) {
+	name := "original"
+
+	if ApplyIfPresent(Empty[string](), &name) {
+		t.Error("ApplyIfPresent should not write an empty Optional")
+	}
+	if name != "original" {
+		t.Errorf("ApplyIfPresent changed the destination to %v", name)
+	}
+
+	if !ApplyIfPresent(Of("updated"), &name) {
+		t.Error("ApplyIfPresent should write a present Optional")
+	}
+	if name != "updated" {
+		t.Errorf("Expected destination 'updated', got %v", name)
+	}
+
+	// A present zero value is still written
+	count := 5
+	if !ApplyIfPresent(Of(0), &count) || count != 0 {
+		t.Errorf("ApplyIfPresent should write a present zero value, got %v", count)
+	}
+}
+
+func TestApplyIfPresentNilDestination(t *testing.T) {
+	for _, opt := range []Optional[int]{Of(1), Empty[int]()} {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("ApplyIfPresent should panic for a nil destination")
+				}
+			}()
+			ApplyIfPresent(opt, nil)
+		}()
+	}
+}
+
+func TestCollectChanged(t *testing.T) {
+	type User struct {
+		Name  string
+		Email string
+		Age   int
+	}
+	user := User{Name: "Alice", Email: "alice@example.com", Age: 28}
+
+	changed := CollectChanged(
+		ApplyIfPresent(Of("Alicia"), &user.Name),
+		ApplyIfPresent(Empty[string](), &user.Email),
+		ApplyIfPresent(Of(29), &user.Age),
+	)
+	if changed != 2 {
+		t.Errorf("CollectChanged() = %v, want 2", changed)
+	}
+	if user != (User{Name: "Alicia", Email: "alice@example.com", Age: 29}) {
+		t.Errorf("Unexpected merged user %+v", user)
+	}
+
+	if CollectChanged() != 0 {
+		t.Error("CollectChanged() with no results should be 0")
+	}
+	if CollectChanged(false, false) != 0 {
+		t.Error("CollectChanged() with nothing applied should be 0")
+	}
+}
@@ -0,0 +1,18 @@
+package optional
+
+import "context"
+
+// FromContextValue looks up key in ctx and returns an Optional containing the
+// stored value if it is present and of type T, or an empty Optional otherwise
+func FromContextValue[T any](ctx context.Context, key any) Optional[T] {
+	if value, ok := ctx.Value(key).(T); ok {
+		return Of(value)
+	}
+	return Empty[T]()
+}
+
+// WithValue returns a copy of ctx carrying value under key. It is the
+// counterpart of FromContextValue and keeps the stored type explicit
+func WithValue[T any](ctx context.Context, key any, value T) context.Context {
+	return context.WithValue(ctx, key, value)
+}
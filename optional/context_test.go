@@ -0,0 +1,63 @@
+package optional
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type contextKey string
+
+func TestFromContextValueAbsentKey(t *testing.T) {
+	opt := FromContextValue[string](context.Background(), contextKey("missing"))
+	if opt.IsPresent() {
+		t.Error("FromContextValue should be empty for an absent key")
+	}
+}
+
+func TestFromContextValueWrongType(t *testing.T) {
+	ctx := WithValue(context.Background(), contextKey("id"), 42)
+
+	opt := FromContextValue[string](ctx, contextKey("id"))
+	if opt.IsPresent() {
+		t.Error("FromContextValue should be empty when the stored value is not a T")
+	}
+}
+
+func TestFromContextValuePresent(t *testing.T) {
+	ctx := WithValue(context.Background(), contextKey("user"), "alice")
+
+	val, ok := FromContextValue[string](ctx, contextKey("user")).GetIfPresent()
+	if !ok || val != "alice" {
+		t.Errorf("Expected value 'alice', got %v, present: %v", val, ok)
+	}
+
+	// A value stored through plain context.WithValue is found as well
+	ctx = context.WithValue(ctx, contextKey("count"), 7)
+	count := FromContextValue[int](ctx, contextKey("count")).OrElse(0)
+	if count != 7 {
+		t.Errorf("Expected value 7, got %v", count)
+	}
+}
+
+func TestFromContextValueInterfaceType(t *testing.T) {
+	storedErr := errors.New("boom")
+	ctx := WithValue[error](context.Background(), contextKey("err"), storedErr)
+
+	val, ok := FromContextValue[error](ctx, contextKey("err")).GetIfPresent()
+	if !ok || val != storedErr {
+		t.Errorf("Expected stored error, got %v, present: %v", val, ok)
+	}
+
+	// Any stored value satisfies the empty interface
+	anyVal, ok := FromContextValue[any](ctx, contextKey("err")).GetIfPresent()
+	if !ok || anyVal != storedErr {
+		t.Errorf("Expected stored error as any, got %v, present: %v", anyVal, ok)
+	}
+
+	// An interface the stored value doesn't implement yields Empty
+	type stringer interface{ String() string }
+	if FromContextValue[stringer](ctx, contextKey("err")).IsPresent() {
+		t.Error("FromContextValue should be empty when the value doesn't implement the interface")
+	}
+}
@@ -0,0 +1,126 @@
+package optional_test
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/tiagods/go-extras/optional"
+)
+
+type User struct {
+	ID       int
+	Name     string
+	Email    string
+	Role     string
+	IsActive bool
+}
+
+var users = map[int]User{
+	1: {ID: 1, Name: "Alice", Email: "alice@example.com", Role: "Admin", IsActive: true},
+	2: {ID: 2, Name: "Bob", Email: "bob@example.com", Role: "User", IsActive: true},
+	3: {ID: 3, Name: "Charlie", Email: "charlie@example.com", Role: "User", IsActive: false},
+}
+
+func findUserByID(id int) optional.Optional[User] {
+	if user, ok := users[id]; ok {
+		return optional.Of(user)
+	}
+	return optional.Empty[User]()
+}
+
+func findActiveUserByEmail(email string) optional.Optional[User] {
+	for _, user := range users {
+		if user.Email == email && user.IsActive {
+			return optional.Of(user)
+		}
+	}
+	return optional.Empty[User]()
+}
+
+func parseInt(s string) optional.Optional[int] {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return optional.Empty[int]()
+	}
+	return optional.Of(n)
+}
+
+func ExampleOptional_GetIfPresent() {
+	if user, found := findUserByID(1).GetIfPresent(); found {
+		fmt.Printf("Found user: %s (ID: %d)\n", user.Name, user.ID)
+	}
+	if _, found := findUserByID(999).GetIfPresent(); !found {
+		fmt.Println("User not found")
+	}
+	// Output:
+	// Found user: Alice (ID: 1)
+	// User not found
+}
+
+func ExampleOptional_IfPresent() {
+	findUserByID(2).IfPresent(func(user User) {
+		fmt.Printf("User %s has role %s\n", user.Name, user.Role)
+	})
+	findUserByID(999).IfPresent(func(user User) {
+		fmt.Println("never printed")
+	})
+	// Output: User Bob has role User
+}
+
+func ExampleOptional_Get() {
+	_, err := findUserByID(999).Get()
+	fmt.Println("Error:", err)
+	// Output: Error: no value present
+}
+
+func ExampleOptional_OrElse() {
+	user := findActiveUserByEmail("charlie@example.com").OrElse(User{Name: "Default User"})
+	fmt.Println(user.Name)
+	// Output: Default User
+}
+
+func ExampleOptional_OrElseGet() {
+	admin := findActiveUserByEmail("alice@example.com").OrElseGet(func() User {
+		return User{Name: "Fallback Admin", Role: "Admin"}
+	})
+	fmt.Printf("%s (%s)\n", admin.Name, admin.Role)
+	// Output: Alice (Admin)
+}
+
+func ExampleOptional_OrElseThrow() {
+	for _, input := range []string{"42", "not-a-number"} {
+		num, err := parseInt(input).OrElseThrow(errors.New("invalid number format"))
+		if err != nil {
+			fmt.Printf("Error for '%s': %v\n", input, err)
+		} else {
+			fmt.Printf("Parsed value: %d\n", num)
+		}
+	}
+	// Output:
+	// Parsed value: 42
+	// Error for 'not-a-number': invalid number format
+}
+
+func ExampleOfNullable() {
+	isZeroInt := func(i int) bool { return i == 0 }
+	isZeroString := func(s string) bool { return s == "" }
+
+	fmt.Println(optional.OfNullable(42, isZeroInt).IsPresent())
+	fmt.Println(optional.OfNullable(0, isZeroInt).IsPresent())
+	fmt.Println(optional.OfNullable("Hello", isZeroString).IsPresent())
+	fmt.Println(optional.OfNullable("", isZeroString).IsPresent())
+	// Output:
+	// true
+	// false
+	// true
+	// false
+}
+
+func ExampleSumOptionals() {
+	fmt.Println(optional.SumOptionals(optional.Of(10), optional.Empty[int](), optional.Of(5)).OrElse(-1))
+	fmt.Println(optional.AllOrNothingSum(optional.Of(10), optional.Empty[int](), optional.Of(5)).OrElse(-1))
+	// Output:
+	// 15
+	// -1
+}
@@ -0,0 +1,36 @@
+package optional
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// MarshalJSON implements the json.Marshaler interface. A present value is
+// encoded as the value itself and an empty Optional as null
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if !o.found {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. null decodes to an
+// empty Optional and any other input is decoded into T and wrapped with Of
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*o = Empty[T]()
+		return nil
+	}
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*o = Of(value)
+	return nil
+}
+
+// IsZero reports whether the Optional is empty, so that fields tagged with
+// `json:",omitzero"` are left out when marshaling
+func (o Optional[T]) IsZero() bool {
+	return !o.found
+}
@@ -0,0 +1,122 @@
+package optional
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type jsonAddress struct {
+	City string `json:"city"`
+	Zip  string `json:"zip"`
+}
+
+type jsonUser struct {
+	Name    Optional[string]      `json:"name"`
+	Age     Optional[int]         `json:"age"`
+	Address Optional[jsonAddress] `json:"address"`
+}
+
+func TestOptionalMarshalJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		user     jsonUser
+		expected string
+	}{
+		{
+			"All present",
+			jsonUser{Name: Of("Alice"), Age: Of(30), Address: Of(jsonAddress{City: "Lisbon", Zip: "1000"})},
+			`{"name":"Alice","age":30,"address":{"city":"Lisbon","zip":"1000"}}`,
+		},
+		{
+			"All empty",
+			jsonUser{Name: Empty[string](), Age: Empty[int](), Address: Empty[jsonAddress]()},
+			`{"name":null,"age":null,"address":null}`,
+		},
+		{
+			"Present zero values",
+			jsonUser{Name: Of(""), Age: Of(0)},
+			`{"name":"","age":0,"address":null}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.user)
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+			if string(data) != tt.expected {
+				t.Errorf("json.Marshal() = %v, want %v", string(data), tt.expected)
+			}
+		})
+	}
+}
+
+func TestOptionalUnmarshalJSON(t *testing.T) {
+	var user jsonUser
+	input := `{"name":"Bob","age":null,"address":{"city":"Porto","zip":"4000"}}`
+	if err := json.Unmarshal([]byte(input), &user); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+
+	if name, _ := user.Name.GetIfPresent(); name != "Bob" {
+		t.Errorf("Name = %v, want Bob", name)
+	}
+	if user.Age.IsPresent() {
+		t.Error("Age should be empty for null input")
+	}
+	if addr, ok := user.Address.GetIfPresent(); !ok || addr.City != "Porto" {
+		t.Errorf("Address = %+v, present: %v, want city Porto", addr, ok)
+	}
+
+	// Missing keys leave the field empty as well
+	var partial jsonUser
+	if err := json.Unmarshal([]byte(`{"age":7}`), &partial); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if partial.Name.IsPresent() || partial.Age.OrElse(0) != 7 {
+		t.Errorf("Unexpected partial decode %+v", partial)
+	}
+}
+
+func TestOptionalJSONRoundTrip(t *testing.T) {
+	original := jsonUser{Name: Of("Carol"), Age: Empty[int](), Address: Of(jsonAddress{City: "Faro"})}
+
+	data, err := json.Marshal(original)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	var decoded jsonUser
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if decoded != original {
+		t.Errorf("Round trip = %+v, want %+v", decoded, original)
+	}
+}
+
+func TestOptionalUnmarshalJSONError(t *testing.T) {
+	var user jsonUser
+	err := json.Unmarshal([]byte(`{"age":"thirty"}`), &user)
+	if err == nil {
+		t.Fatal("json.Unmarshal() should surface the inner decode error")
+	}
+	if _, ok := err.(*json.UnmarshalTypeError); !ok {
+		t.Errorf("json.Unmarshal() error = %T %v, want *json.UnmarshalTypeError", err, err)
+	}
+}
+
+func TestOptionalOmitZero(t *testing.T) {
+	type dto struct {
+		Nickname Optional[string] `json:"nickname,omitzero"`
+		Score    Optional[int]    `json:"score,omitzero"`
+	}
+
+	data, err := json.Marshal(dto{Score: Of(0)})
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if string(data) != `{"score":0}` {
+		t.Errorf("json.Marshal() = %v, want {\"score\":0}", string(data))
+	}
+}
@@ -0,0 +1,19 @@
+package optional
+
+// Get2 applies an accessor returning an Optional to the value of o, if present.
+// It is equivalent to FlatMap and exists for symmetry with Get3 and Get4
+func Get2[A, B any](o Optional[A], f1 func(A) Optional[B]) Optional[B] {
+	return FlatMap(o, f1)
+}
+
+// Get3 navigates two levels of optional accessors in one call, stopping at the
+// first empty result without calling the remaining accessors
+func Get3[A, B, C any](o Optional[A], f1 func(A) Optional[B], f2 func(B) Optional[C]) Optional[C] {
+	return Get2(Get2(o, f1), f2)
+}
+
+// Get4 navigates three levels of optional accessors in one call, stopping at
+// the first empty result without calling the remaining accessors
+func Get4[A, B, C, D any](o Optional[A], f1 func(A) Optional[B], f2 func(B) Optional[C], f3 func(C) Optional[D]) Optional[D] {
+	return Get2(Get3(o, f1, f2), f3)
+}
@@ -0,0 +1,94 @@
+package optional
+
+import "testing"
+
+type geo struct {
+	Lat float64
+}
+
+type address struct {
+	Geo *geo
+}
+
+type company struct {
+	Address *address
+}
+
+func TestGet2(t *testing.T) {
+	calls := 0
+	name := func(c company) Optional[*address] {
+		calls++
+		if c.Address == nil {
+			return Empty[*address]()
+		}
+		return Of(c.Address)
+	}
+
+	if !Get2(Of(company{Address: &address{}}), name).IsPresent() {
+		t.Error("Get2 should be present when every level is present")
+	}
+	if Get2(Of(company{}), name).IsPresent() {
+		t.Error("Get2 should be empty when the accessor returns empty")
+	}
+
+	calls = 0
+	if Get2(Empty[company](), name).IsPresent() || calls != 0 {
+		t.Errorf("Get2 should not call the accessor for an empty Optional, got %v calls", calls)
+	}
+}
+
+func TestGet4ShortCircuits(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     Optional[company]
+		wantLat   float64
+		wantFound bool
+		wantCalls [3]int
+	}{
+		{"All levels present", Of(company{Address: &address{Geo: &geo{Lat: -23.5}}}), -23.5, true, [3]int{1, 1, 1}},
+		{"Empty root", Empty[company](), 0, false, [3]int{0, 0, 0}},
+		{"Missing address", Of(company{}), 0, false, [3]int{1, 0, 0}},
+		{"Missing geo", Of(company{Address: &address{}}), 0, false, [3]int{1, 1, 0}},
+		{"Zero latitude", Of(company{Address: &address{Geo: &geo{}}}), 0, false, [3]int{1, 1, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls [3]int
+			lat := Get4(tt.input,
+				func(c company) Optional[*address] {
+					calls[0]++
+					return OfNullable(c.Address, func(a *address) bool { return a == nil })
+				},
+				func(a *address) Optional[*geo] {
+					calls[1]++
+					return OfNullable(a.Geo, func(g *geo) bool { return g == nil })
+				},
+				func(g *geo) Optional[float64] {
+					calls[2]++
+					return OfNullable(g.Lat, func(f float64) bool { return f == 0 })
+				},
+			)
+
+			val, found := lat.GetIfPresent()
+			if found != tt.wantFound || val != tt.wantLat {
+				t.Errorf("Get4() = %v, present: %v, want %v, present: %v", val, found, tt.wantLat, tt.wantFound)
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("Get4() accessor calls = %v, want %v", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestGet3(t *testing.T) {
+	double := func(i int) Optional[int] { return Of(i * 2) }
+	positive := func(i int) Optional[int] { return OfNullable(i, func(v int) bool { return v <= 0 }) }
+
+	if val, _ := Get3(Of(2), double, positive).GetIfPresent(); val != 4 {
+		t.Errorf("Get3() = %v, want 4", val)
+	}
+	if Get3(Of(-1), double, positive).IsPresent() {
+		t.Error("Get3 should be empty when the last accessor returns empty")
+	}
+}
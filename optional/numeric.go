@@ -0,0 +1,72 @@
+package optional
+
+import "cmp"
+
+// Number is a constraint that permits any integer or floating-point type
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// SumOptionals returns the sum of the present values, skipping empty ones.
+// The result is empty only when every input is empty
+func SumOptionals[T Number](opts ...Optional[T]) Optional[T] {
+	return combinePresent(opts, func(a, b T) T { return a + b })
+}
+
+// AllOrNothingSum returns the sum of the values, or an empty Optional if any
+// input is empty or no inputs are given
+func AllOrNothingSum[T Number](opts ...Optional[T]) Optional[T] {
+	return combineAll(opts, func(a, b T) T { return a + b })
+}
+
+// MaxOptional returns the largest present value, skipping empty ones.
+// The result is empty only when every input is empty
+func MaxOptional[T cmp.Ordered](opts ...Optional[T]) Optional[T] {
+	return combinePresent(opts, func(a, b T) T { return max(a, b) })
+}
+
+// AllOrNothingMax returns the largest value, or an empty Optional if any input
+// is empty or no inputs are given
+func AllOrNothingMax[T cmp.Ordered](opts ...Optional[T]) Optional[T] {
+	return combineAll(opts, func(a, b T) T { return max(a, b) })
+}
+
+// MinOptional returns the smallest present value, skipping empty ones.
+// The result is empty only when every input is empty
+func MinOptional[T cmp.Ordered](opts ...Optional[T]) Optional[T] {
+	return combinePresent(opts, func(a, b T) T { return min(a, b) })
+}
+
+// AllOrNothingMin returns the smallest value, or an empty Optional if any input
+// is empty or no inputs are given
+func AllOrNothingMin[T cmp.Ordered](opts ...Optional[T]) Optional[T] {
+	return combineAll(opts, func(a, b T) T { return min(a, b) })
+}
+
+// combinePresent folds the present values with combine, ignoring empty ones
+func combinePresent[T any](opts []Optional[T], combine func(T, T) T) Optional[T] {
+	result := Empty[T]()
+	for _, o := range opts {
+		if !o.found {
+			continue
+		}
+		if result.found {
+			result.value = combine(result.value, o.value)
+		} else {
+			result = o
+		}
+	}
+	return result
+}
+
+// combineAll folds the values with combine, returning empty if any is empty
+func combineAll[T any](opts []Optional[T], combine func(T, T) T) Optional[T] {
+	for _, o := range opts {
+		if !o.found {
+			return Empty[T]()
+		}
+	}
+	return combinePresent(opts, combine)
+}
@@ -0,0 +1,77 @@
+package optional
+
+import "testing"
+
+type numericCase struct {
+	name      string
+	inputs    []Optional[int]
+	wantValue int
+	wantFound bool
+}
+
+func checkNumeric(t *testing.T, fn func(...Optional[int]) Optional[int], tests []numericCase) {
+	t.Helper()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			val, found := fn(tt.inputs...).GetIfPresent()
+			if found != tt.wantFound || val != tt.wantValue {
+				t.Errorf("got %v, present: %v, want %v, present: %v", val, found, tt.wantValue, tt.wantFound)
+			}
+		})
+	}
+}
+
+func TestSumOptionals(t *testing.T) {
+	checkNumeric(t, SumOptionals[int], []numericCase{
+		{"No inputs", nil, 0, false},
+		{"All empty", []Optional[int]{Empty[int](), Empty[int]()}, 0, false},
+		{"All present", []Optional[int]{Of(1), Of(2), Of(3)}, 6, true},
+		{"Mixed", []Optional[int]{Of(1), Empty[int](), Of(3)}, 4, true},
+		{"Single present zero", []Optional[int]{Empty[int](), Of(0)}, 0, true},
+	})
+
+	if val, _ := SumOptionals(Of(1.5), Empty[float64](), Of(2.25)).GetIfPresent(); val != 3.75 {
+		t.Errorf("SumOptionals() float = %v, want 3.75", val)
+	}
+}
+
+func TestAllOrNothingSum(t *testing.T) {
+	checkNumeric(t, AllOrNothingSum[int], []numericCase{
+		{"No inputs", nil, 0, false},
+		{"All empty", []Optional[int]{Empty[int](), Empty[int]()}, 0, false},
+		{"All present", []Optional[int]{Of(1), Of(2), Of(3)}, 6, true},
+		{"Mixed", []Optional[int]{Of(1), Empty[int](), Of(3)}, 0, false},
+	})
+}
+
+func TestMaxOptional(t *testing.T) {
+	checkNumeric(t, MaxOptional[int], []numericCase{
+		{"No inputs", nil, 0, false},
+		{"All empty", []Optional[int]{Empty[int]()}, 0, false},
+		{"All present", []Optional[int]{Of(4), Of(9), Of(-2)}, 9, true},
+		{"Mixed", []Optional[int]{Empty[int](), Of(-5), Empty[int](), Of(-7)}, -5, true},
+	})
+	checkNumeric(t, AllOrNothingMax[int], []numericCase{
+		{"No inputs", nil, 0, false},
+		{"All present", []Optional[int]{Of(4), Of(9), Of(-2)}, 9, true},
+		{"Mixed", []Optional[int]{Of(4), Empty[int]()}, 0, false},
+	})
+}
+
+func TestMinOptional(t *testing.T) {
+	checkNumeric(t, MinOptional[int], []numericCase{
+		{"No inputs", nil, 0, false},
+		{"All empty", []Optional[int]{Empty[int]()}, 0, false},
+		{"All present", []Optional[int]{Of(4), Of(9), Of(-2)}, -2, true},
+		{"Mixed", []Optional[int]{Empty[int](), Of(5), Of(7)}, 5, true},
+	})
+	checkNumeric(t, AllOrNothingMin[int], []numericCase{
+		{"No inputs", nil, 0, false},
+		{"All present", []Optional[int]{Of(4), Of(9), Of(-2)}, -2, true},
+		{"Mixed", []Optional[int]{Empty[int](), Of(5)}, 0, false},
+	})
+
+	if val, _ := MinOptional(Of("pear"), Empty[string](), Of("apple")).GetIfPresent(); val != "apple" {
+		t.Errorf("MinOptional() string = %v, want apple", val)
+	}
+}
@@ -0,0 +1,28 @@
+package optional
+
+import (
+	"reflect"
+	"sync/atomic"
+)
+
+var emptyObserver atomic.Pointer[func(typeName string)]
+
+// SetEmptyObserver installs a function that is called with the name of the
+// element type whenever OrElse, OrElseGet, OrElseThrow or OrElseZero fall back
+// because the Optional is empty. It is meant for wiring metrics counters and
+// must be safe for concurrent calls. Passing nil removes the observer, which is
+// the default
+func SetEmptyObserver(observer func(typeName string)) {
+	if observer == nil {
+		emptyObserver.Store(nil)
+		return
+	}
+	emptyObserver.Store(&observer)
+}
+
+// notifyEmpty reports an empty fallback to the installed observer, if any
+func notifyEmpty[T any]() {
+	if observer := emptyObserver.Load(); observer != nil {
+		(*observer)(reflect.TypeFor[T]().String())
+	}
+}
@@ -0,0 +1,77 @@
+package optional
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestEmptyObserverFiresOnlyOnEmptyPaths(t *testing.T) {
+	var mu sync.Mutex
+	counts := map[string]int{}
+	SetEmptyObserver(func(typeName string) {
+		mu.Lock()
+		defer mu.Unlock()
+		counts[typeName]++
+	})
+	defer SetEmptyObserver(nil)
+
+	present := Of("value")
+	present.OrElse("default")
+	present.OrElseGet(func() string { return "default" })
+	present.OrElseThrow(errors.New("missing"))
+	present.OrElseZero()
+	if len(counts) != 0 {
+		t.Errorf("Observer should not fire for present values, got %v", counts)
+	}
+
+	empty := Empty[string]()
+	empty.OrElse("default")
+	empty.OrElseGet(func() string { return "default" })
+	empty.OrElseThrow(errors.New("missing"))
+	empty.OrElseZero()
+	Empty[int]().OrElse(0)
+
+	// Other accessors don't report to the observer
+	empty.Get()
+	empty.GetIfPresent()
+
+	if counts["string"] != 4 {
+		t.Errorf("Observer count for string = %v, want 4", counts["string"])
+	}
+	if counts["int"] != 1 {
+		t.Errorf("Observer count for int = %v, want 1", counts["int"])
+	}
+}
+
+func TestEmptyObserverRemoved(t *testing.T) {
+	var calls int32
+	SetEmptyObserver(func(string) { atomic.AddInt32(&calls, 1) })
+	SetEmptyObserver(nil)
+
+	Empty[string]().OrElse("default")
+	if calls != 0 {
+		t.Errorf("Observer should not fire after being removed, got %v calls", calls)
+	}
+}
+
+func TestEmptyObserverConcurrent(t *testing.T) {
+	var calls int32
+	SetEmptyObserver(func(string) { atomic.AddInt32(&calls, 1) })
+	defer SetEmptyObserver(nil)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			Empty[int]().OrElse(0)
+		}()
+	}
+	wg.Wait()
+
+	if calls != 50 {
+		t.Errorf("Observer calls = %v, want 50", calls)
+	}
+}
@@ -34,6 +34,25 @@ func OfNullable[T comparable](value T, isZero func(T) bool) Optional[T] {
 	return Of(value)
 }
 
+// FromPtr creates an Optional from a pointer, returning an empty Optional for
+// nil or an Optional containing the pointed-to value otherwise
+func FromPtr[T any](p *T) Optional[T] {
+	if p == nil {
+		return Empty[T]()
+	}
+	return Of(*p)
+}
+
+// ToPtr returns nil if the Optional is empty, or a pointer to a copy of the
+// value otherwise, so that callers can't modify the Optional through it
+func (o Optional[T]) ToPtr() *T {
+	if !o.found {
+		return nil
+	}
+	value := o.value
+	return &value
+}
+
 // GetIfPresent returns the value and a boolean indicating if the value is present
 func (o Optional[T]) GetIfPresent() (T, bool) {
 	if o.found {
@@ -57,11 +76,31 @@ func (o Optional[T]) Get() (T, error) {
 	return empty, ErrNoValuePresent
 }
 
+// MustGet returns the value, panicking with ErrNoValuePresent if it is not
+// present. Use it only where an empty Optional is an unrecoverable programming
+// error, such as initialization code and tests
+func (o Optional[T]) MustGet() T {
+	if !o.found {
+		panic(ErrNoValuePresent)
+	}
+	return o.value
+}
+
+// Expect returns the value, panicking with the given message if it is not
+// present. Like MustGet, it is meant for unrecoverable situations only
+func (o Optional[T]) Expect(msg string) T {
+	if !o.found {
+		panic(msg)
+	}
+	return o.value
+}
+
 // OrElse returns the value if present, or the provided default value
 func (o Optional[T]) OrElse(defaultValue T) T {
 	if o.found {
 		return o.value
 	}
+	notifyEmpty[T]()
 	return defaultValue
 }
 
@@ -72,11 +111,26 @@ func (o Optional[T]) IfPresent(consumer func(T)) {
 	}
 }
 
+// IfPresentOrElse executes onPresent with the value if present, or onEmpty
+// otherwise. Exactly one branch runs; a nil callback is treated as a no-op
+func (o Optional[T]) IfPresentOrElse(onPresent func(T), onEmpty func()) {
+	if o.found {
+		if onPresent != nil {
+			onPresent(o.value)
+		}
+		return
+	}
+	if onEmpty != nil {
+		onEmpty()
+	}
+}
+
 // OrElseGet returns the value if present, or obtains a default value from a supplier function
 func (o Optional[T]) OrElseGet(supplier func() T) T {
 	if o.found {
 		return o.value
 	}
+	notifyEmpty[T]()
 	return supplier()
 }
 
@@ -85,6 +139,77 @@ func (o Optional[T]) OrElseThrow(err error) (T, error) {
 	if o.found {
 		return o.value, nil
 	}
+	notifyEmpty[T]()
 	var empty T
 	return empty, err
 }
+
+// Ensure checks an invariant on the value. It returns the Optional unchanged and
+// a nil error when it is empty or the predicate holds, or an empty Optional and
+// the provided error when a present value violates the predicate
+func (o Optional[T]) Ensure(predicate func(T) bool, err error) (Optional[T], error) {
+	return o.EnsureFunc(predicate, func(T) error { return err })
+}
+
+// EnsureFunc is like Ensure, but builds the error from the offending value only
+// when the predicate is violated
+func (o Optional[T]) EnsureFunc(predicate func(T) bool, errorFn func(T) error) (Optional[T], error) {
+	if o.found && !predicate(o.value) {
+		return Empty[T](), errorFn(o.value)
+	}
+	return o, nil
+}
+
+// Filter returns the Optional unchanged if the value is present and satisfies
+// the predicate, or an empty Optional otherwise
+func (o Optional[T]) Filter(predicate func(T) bool) Optional[T] {
+	if o.found && predicate(o.value) {
+		return o
+	}
+	return Empty[T]()
+}
+
+// Map applies a function to the value if present and returns an Optional with
+// the result, or an empty Optional without calling the function otherwise
+func Map[T, R any](o Optional[T], mapper func(T) R) Optional[R] {
+	if o.found {
+		return Of(mapper(o.value))
+	}
+	return Empty[R]()
+}
+
+// FlatMap applies a function returning an Optional to the value if present, or
+// returns an empty Optional without calling the function otherwise
+func FlatMap[T, R any](o Optional[T], mapper func(T) Optional[R]) Optional[R] {
+	if o.found {
+		return mapper(o.value)
+	}
+	return Empty[R]()
+}
+
+// Or returns the Optional if the value is present, or other otherwise
+func (o Optional[T]) Or(other Optional[T]) Optional[T] {
+	if o.found {
+		return o
+	}
+	return other
+}
+
+// OrFunc returns the Optional if the value is present, or obtains an Optional
+// from a supplier function otherwise. The supplier is only called when empty
+func (o Optional[T]) OrFunc(supplier func() Optional[T]) Optional[T] {
+	if o.found {
+		return o
+	}
+	return supplier()
+}
+
+// OrElseZero returns the value if present, or the zero value of T
+func (o Optional[T]) OrElseZero() T {
+	if o.found {
+		return o.value
+	}
+	notifyEmpty[T]()
+	var zero T
+	return zero
+}
@@ -3,6 +3,7 @@ package optional
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 )
 
@@ -334,3 +335,331 @@ func ExampleOptional() {
 	// opt2 está presente: false
 	// Valor de opt2 ou padrão: valor padrão
 }
+
+func TestOptionalEnsure(t *testing.T) {
+	errOutOfRange := errors.New("discount out of range")
+	inRange := func(d float64) bool { return d >= 0 && d <= 1 }
+
+	opt, err := Of(0.25).Ensure(inRange, errOutOfRange)
+	if err != nil || opt.OrElse(-1) != 0.25 {
+		t.Errorf("Ensure should keep a valid value, got %v, err=%v", opt.OrElse(-1), err)
+	}
+
+	opt, err = Of(1.5).Ensure(inRange, errOutOfRange)
+	if err != errOutOfRange {
+		t.Errorf("Ensure should return the provided error, got %v", err)
+	}
+	if opt.IsPresent() {
+		t.Error("Ensure should return an empty Optional for an invalid value")
+	}
+
+	predicateCalled := false
+	opt, err = Empty[float64]().Ensure(func(float64) bool {
+		predicateCalled = true
+		return false
+	}, errOutOfRange)
+	if err != nil || opt.IsPresent() || predicateCalled {
+		t.Errorf("Ensure should pass empty Optionals through, got err=%v, predicate called=%v", err, predicateCalled)
+	}
+}
+
+func TestOptionalEnsureFunc(t *testing.T) {
+	positive := func(i int) bool { return i > 0 }
+	errorCalls := 0
+	errorFn := func(i int) error {
+		errorCalls++
+		return &CustomError{Code: 400, Message: fmt.Sprintf("%d is not positive", i)}
+	}
+
+	opt, err := Of(3).EnsureFunc(positive, errorFn)
+	if err != nil || opt.OrElse(0) != 3 || errorCalls != 0 {
+		t.Errorf("EnsureFunc should keep a valid value without building an error, got err=%v, calls=%v", err, errorCalls)
+	}
+
+	_, err = Empty[int]().EnsureFunc(positive, errorFn)
+	if err != nil || errorCalls != 0 {
+		t.Errorf("EnsureFunc should not build an error for an empty Optional, got err=%v, calls=%v", err, errorCalls)
+	}
+
+	opt, err = Of(-2).EnsureFunc(positive, errorFn)
+	if err == nil || err.Error() != "Error 400: -2 is not positive" {
+		t.Errorf("EnsureFunc should return the built error, got %v", err)
+	}
+	if opt.IsPresent() || errorCalls != 1 {
+		t.Errorf("EnsureFunc should return empty and build the error once, got present=%v, calls=%v", opt.IsPresent(), errorCalls)
+	}
+}
+
+func TestOptionalMap(t *testing.T) {
+	calls := 0
+	length := func(s string) int {
+		calls++
+		return len(s)
+	}
+
+	if val, ok := Map(Of("hello"), length).GetIfPresent(); !ok || val != 5 {
+		t.Errorf("Map should transform a present value, got %v, present: %v", val, ok)
+	}
+
+	if Map(Empty[string](), length).IsPresent() {
+		t.Error("Map should return empty for an empty Optional")
+	}
+	if calls != 1 {
+		t.Errorf("Map should only call the mapper for present values, got %v calls", calls)
+	}
+}
+
+func TestOptionalFlatMap(t *testing.T) {
+	calls := 0
+	parse := func(s string) Optional[int] {
+		calls++
+		var n int
+		if _, err := fmt.Sscanf(s, "%d", &n); err != nil {
+			return Empty[int]()
+		}
+		return Of(n)
+	}
+
+	if val, ok := FlatMap(Of("42"), parse).GetIfPresent(); !ok || val != 42 {
+		t.Errorf("FlatMap should return the mapper result, got %v, present: %v", val, ok)
+	}
+	if FlatMap(Of("abc"), parse).IsPresent() {
+		t.Error("FlatMap should return empty when the mapper returns empty")
+	}
+	if FlatMap(Empty[string](), parse).IsPresent() {
+		t.Error("FlatMap should return empty for an empty Optional")
+	}
+	if calls != 2 {
+		t.Errorf("FlatMap should only call the mapper for present values, got %v calls", calls)
+	}
+}
+
+func TestOptionalFilter(t *testing.T) {
+	calls := 0
+	even := func(i int) bool {
+		calls++
+		return i%2 == 0
+	}
+
+	if val, ok := Of(4).Filter(even).GetIfPresent(); !ok || val != 4 {
+		t.Errorf("Filter should keep a value satisfying the predicate, got %v, present: %v", val, ok)
+	}
+	if Of(3).Filter(even).IsPresent() {
+		t.Error("Filter should return empty when the predicate fails")
+	}
+	if Empty[int]().Filter(even).IsPresent() {
+		t.Error("Filter should return empty for an empty Optional")
+	}
+	if calls != 2 {
+		t.Errorf("Filter should only call the predicate for present values, got %v calls", calls)
+	}
+}
+
+func TestOptionalMapFilterOrElse(t *testing.T) {
+	mapCalls, filterCalls := 0, 0
+	pipeline := func(o Optional[string]) string {
+		upper := Map(o, func(s string) string {
+			mapCalls++
+			return strings.ToUpper(s)
+		})
+		return upper.Filter(func(s string) bool {
+			filterCalls++
+			return len(s) > 3
+		}).OrElse("DEFAULT")
+	}
+
+	tests := []struct {
+		name     string
+		input    Optional[string]
+		expected string
+	}{
+		{"Present and kept", Of("alice"), "ALICE"},
+		{"Present and filtered out", Of("bob"), "DEFAULT"},
+		{"Empty", Empty[string](), "DEFAULT"},
+	}
+	for _, tt := range tests {
+		if got := pipeline(tt.input); got != tt.expected {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.expected)
+		}
+	}
+
+	if mapCalls != 2 || filterCalls != 2 {
+		t.Errorf("Expected 2 map and 2 filter calls, got %v and %v", mapCalls, filterCalls)
+	}
+}
+
+func TestOptionalOr(t *testing.T) {
+	primary := Of("primary")
+	secondary := Of("secondary")
+	empty := Empty[string]()
+
+	if primary.Or(secondary).OrElse("") != "primary" {
+		t.Error("Or should keep a present receiver")
+	}
+	if empty.Or(secondary).OrElse("") != "secondary" {
+		t.Error("Or should fall back to other for an empty receiver")
+	}
+	if empty.Or(Empty[string]()).IsPresent() {
+		t.Error("Or should be empty when both are empty")
+	}
+
+	guest := empty.Or(empty).Or(Of("guest")).OrElse("")
+	if guest != "guest" {
+		t.Errorf("Chained Or should return the first present value, got %v", guest)
+	}
+}
+
+func TestOptionalOrFunc(t *testing.T) {
+	supplierCalled := false
+	supplier := func() Optional[string] {
+		supplierCalled = true
+		return Of("supplied")
+	}
+
+	if Of("test").OrFunc(supplier).OrElse("") != "test" {
+		t.Error("OrFunc should keep a present receiver")
+	}
+	if supplierCalled {
+		t.Error("Supplier should not be called when value is present")
+	}
+
+	if Empty[string]().OrFunc(supplier).OrElse("") != "supplied" {
+		t.Error("OrFunc should return the supplier result for an empty receiver")
+	}
+	if !supplierCalled {
+		t.Error("Supplier should be called when value is not present")
+	}
+}
+
+func TestOptionalOrElseZero(t *testing.T) {
+	if Of(42).OrElseZero() != 42 {
+		t.Error("OrElseZero should return the value when present")
+	}
+	if Empty[int]().OrElseZero() != 0 {
+		t.Error("OrElseZero should return zero when empty")
+	}
+	if Empty[*CustomError]().OrElseZero() != nil {
+		t.Error("OrElseZero should return nil for empty pointer Optionals")
+	}
+}
+
+func TestOptionalIfPresentOrElse(t *testing.T) {
+	var presentCalls, emptyCalls int
+	var received string
+	onPresent := func(s string) {
+		presentCalls++
+		received = s
+	}
+	onEmpty := func() { emptyCalls++ }
+
+	Of("test").IfPresentOrElse(onPresent, onEmpty)
+	if presentCalls != 1 || emptyCalls != 0 || received != "test" {
+		t.Errorf("Present Optional should only run onPresent, got present=%v empty=%v value=%v", presentCalls, emptyCalls, received)
+	}
+
+	presentCalls, emptyCalls = 0, 0
+	Empty[string]().IfPresentOrElse(onPresent, onEmpty)
+	if presentCalls != 0 || emptyCalls != 1 {
+		t.Errorf("Empty Optional should only run onEmpty, got present=%v empty=%v", presentCalls, emptyCalls)
+	}
+
+	// Nil callbacks are tolerated for the branch the caller doesn't care about
+	Of("test").IfPresentOrElse(nil, onEmpty)
+	Empty[string]().IfPresentOrElse(onPresent, nil)
+	Of("test").IfPresentOrElse(onPresent, nil)
+	Empty[string]().IfPresentOrElse(nil, onEmpty)
+	if presentCalls != 1 || emptyCalls != 2 {
+		t.Errorf("Nil callbacks changed which branch ran, got present=%v empty=%v", presentCalls, emptyCalls)
+	}
+}
+
+// recoverPanic runs fn and returns the value it panicked with, if any
+func recoverPanic(fn func()) (recovered any) {
+	defer func() {
+		recovered = recover()
+	}()
+	fn()
+	return nil
+}
+
+func TestOptionalMustGet(t *testing.T) {
+	var value int
+	if r := recoverPanic(func() { value = Of(42).MustGet() }); r != nil {
+		t.Errorf("MustGet should not panic for a present value, got %v", r)
+	}
+	if value != 42 {
+		t.Errorf("MustGet should return the value, got %v", value)
+	}
+
+	r := recoverPanic(func() { Empty[int]().MustGet() })
+	if err, ok := r.(error); !ok || !errors.Is(err, ErrNoValuePresent) {
+		t.Errorf("MustGet should panic with ErrNoValuePresent, got %v", r)
+	}
+}
+
+func TestOptionalExpect(t *testing.T) {
+	var value string
+	if r := recoverPanic(func() { value = Of("config").Expect("config must be loaded") }); r != nil {
+		t.Errorf("Expect should not panic for a present value, got %v", r)
+	}
+	if value != "config" {
+		t.Errorf("Expect should return the value, got %v", value)
+	}
+
+	r := recoverPanic(func() { Empty[string]().Expect("config must be loaded") })
+	if r != "config must be loaded" {
+		t.Errorf("Expect should panic with the supplied message, got %v", r)
+	}
+}
+
+func TestOptionalFromPtr(t *testing.T) {
+	var nilPtr *string
+	if FromPtr(nilPtr).IsPresent() {
+		t.Error("FromPtr should be empty for a nil pointer")
+	}
+
+	name := "Alice"
+	opt := FromPtr(&name)
+	if val, ok := opt.GetIfPresent(); !ok || val != "Alice" {
+		t.Errorf("Expected value 'Alice', got %v, present: %v", val, ok)
+	}
+
+	// The Optional holds a copy of the pointed-to value
+	name = "Bob"
+	if opt.OrElse("") != "Alice" {
+		t.Errorf("FromPtr should copy the value, got %v", opt.OrElse(""))
+	}
+}
+
+func TestOptionalToPtr(t *testing.T) {
+	if Empty[int]().ToPtr() != nil {
+		t.Error("ToPtr should return nil for an empty Optional")
+	}
+
+	opt := Of(42)
+	ptr := opt.ToPtr()
+	if ptr == nil || *ptr != 42 {
+		t.Fatalf("ToPtr should point to the value, got %v", ptr)
+	}
+
+	// Mutating the returned pointer doesn't change the Optional
+	*ptr = 7
+	if opt.OrElse(0) != 42 {
+		t.Errorf("ToPtr should return a copy, Optional now holds %v", opt.OrElse(0))
+	}
+	if opt.ToPtr() == ptr {
+		t.Error("ToPtr should return a new pointer on every call")
+	}
+}
+
+func TestOptionalPtrRoundTrip(t *testing.T) {
+	if FromPtr(Empty[string]().ToPtr()).IsPresent() {
+		t.Error("Empty Optional should round-trip through a pointer as empty")
+	}
+
+	type Point struct{ X, Y int }
+	original := Of(Point{X: 1, Y: 2})
+	if got := FromPtr(original.ToPtr()); got != original {
+		t.Errorf("Round trip = %v, want %v", got, original)
+	}
+}
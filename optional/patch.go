@@ -0,0 +1,116 @@
+package optional
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+type patchState uint8
+
+const (
+	patchUnset patchState = iota
+	patchClear
+	patchSet
+)
+
+// Patch represents a field of a PATCH-style request, which has three states:
+// unset (leave the field untouched), clear (reset the field) and set (assign a
+// new value). The zero value is unset
+type Patch[T any] struct {
+	value T
+	state patchState
+}
+
+// PatchUnset creates a Patch that leaves the field untouched
+func PatchUnset[T any]() Patch[T] {
+	return Patch[T]{state: patchUnset}
+}
+
+// PatchClear creates a Patch that clears the field
+func PatchClear[T any]() Patch[T] {
+	return Patch[T]{state: patchClear}
+}
+
+// PatchSet creates a Patch that assigns the given value
+func PatchSet[T any](value T) Patch[T] {
+	return Patch[T]{value: value, state: patchSet}
+}
+
+// IsUnset returns true if the field should be left untouched
+func (p Patch[T]) IsUnset() bool {
+	return p.state == patchUnset
+}
+
+// IsClear returns true if the field should be cleared
+func (p Patch[T]) IsClear() bool {
+	return p.state == patchClear
+}
+
+// IsSet returns true if the field should be assigned a value
+func (p Patch[T]) IsSet() bool {
+	return p.state == patchSet
+}
+
+// IsZero reports whether the Patch is unset, so that fields tagged with
+// `json:",omitzero"` are left out when marshaling
+func (p Patch[T]) IsZero() bool {
+	return p.IsUnset()
+}
+
+// Get returns the value and an error if the Patch doesn't set a value
+func (p Patch[T]) Get() (T, error) {
+	if p.state == patchSet {
+		return p.value, nil
+	}
+	var empty T
+	return empty, ErrNoValuePresent
+}
+
+// Optional returns an Optional with the value if the Patch sets one, or an
+// empty Optional otherwise
+func (p Patch[T]) Optional() Optional[T] {
+	if p.state == patchSet {
+		return Of(p.value)
+	}
+	return Empty[T]()
+}
+
+// MarshalJSON implements the json.Marshaler interface. A set Patch is encoded
+// as its value and any other state as null; use the omitzero tag option to
+// leave unset fields out
+func (p Patch[T]) MarshalJSON() ([]byte, error) {
+	if p.state == patchSet {
+		return json.Marshal(p.value)
+	}
+	return []byte("null"), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. An explicit null
+// clears the field and any other value sets it. Keys missing from the input
+// never reach this method, so such fields keep their unset zero value
+func (p *Patch[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*p = PatchClear[T]()
+		return nil
+	}
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*p = PatchSet(value)
+	return nil
+}
+
+// DecodePatch builds a Patch from the raw fields of a JSON object: a missing
+// key is unset, an explicit null is clear and any other value is decoded and set
+func DecodePatch[T any](fields map[string]json.RawMessage, key string) (Patch[T], error) {
+	raw, ok := fields[key]
+	if !ok {
+		return PatchUnset[T](), nil
+	}
+	var p Patch[T]
+	if err := p.UnmarshalJSON(raw); err != nil {
+		return PatchUnset[T](), err
+	}
+	return p, nil
+}
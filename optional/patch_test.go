@@ -0,0 +1,105 @@
+package optional
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestPatchStates(t *testing.T) {
+	unset := PatchUnset[string]()
+	if !unset.IsUnset() || unset.IsClear() || unset.IsSet() {
+		t.Error("Unset should only be unset")
+	}
+
+	var zero Patch[string]
+	if !zero.IsUnset() {
+		t.Error("Zero value Patch should be unset")
+	}
+
+	cleared := PatchClear[string]()
+	if cleared.IsUnset() || !cleared.IsClear() || cleared.IsSet() {
+		t.Error("Clear should only be clear")
+	}
+
+	set := PatchSet("value")
+	if set.IsUnset() || set.IsClear() || !set.IsSet() {
+		t.Error("Set should only be set")
+	}
+}
+
+func TestPatchGet(t *testing.T) {
+	value, err := PatchSet(42).Get()
+	if err != nil || value != 42 {
+		t.Errorf("Get should return value without error, got value=%v, err=%v", value, err)
+	}
+
+	for _, p := range []Patch[int]{PatchUnset[int](), PatchClear[int]()} {
+		value, err := p.Get()
+		if err != ErrNoValuePresent || value != 0 {
+			t.Errorf("Get should return ErrNoValuePresent, got value=%v, err=%v", value, err)
+		}
+	}
+
+	if !PatchSet("x").Optional().IsPresent() {
+		t.Error("Optional should be present for a set Patch")
+	}
+	if PatchClear[string]().Optional().IsPresent() || PatchUnset[string]().Optional().IsPresent() {
+		t.Error("Optional should be empty for unset and clear Patches")
+	}
+}
+
+func TestDecodePatch(t *testing.T) {
+	var fields map[string]json.RawMessage
+	input := `{"name": "Alice", "nickname": null}`
+	if err := json.Unmarshal([]byte(input), &fields); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+
+	name, err := DecodePatch[string](fields, "name")
+	if err != nil {
+		t.Fatalf("DecodePatch(name) error = %v", err)
+	}
+	if value, _ := name.Get(); !name.IsSet() || value != "Alice" {
+		t.Errorf("DecodePatch(name) should be set to 'Alice', got %v", value)
+	}
+
+	nickname, err := DecodePatch[string](fields, "nickname")
+	if err != nil || !nickname.IsClear() {
+		t.Errorf("DecodePatch(nickname) should be clear, got %+v, err=%v", nickname, err)
+	}
+
+	email, err := DecodePatch[string](fields, "email")
+	if err != nil || !email.IsUnset() {
+		t.Errorf("DecodePatch(email) should be unset, got %+v, err=%v", email, err)
+	}
+
+	_, err = DecodePatch[int](fields, "name")
+	if err == nil {
+		t.Error("DecodePatch should surface decode errors of the value")
+	}
+}
+
+func TestPatchJSONRoundTrip(t *testing.T) {
+	type UserPatch struct {
+		Name     Patch[string] `json:"name,omitzero"`
+		Nickname Patch[string] `json:"nickname,omitzero"`
+		Age      Patch[int]    `json:"age,omitzero"`
+	}
+
+	var patch UserPatch
+	if err := json.Unmarshal([]byte(`{"name":"Bob","nickname":null}`), &patch); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if !patch.Name.IsSet() || !patch.Nickname.IsClear() || !patch.Age.IsUnset() {
+		t.Errorf("Unexpected patch states: %+v", patch)
+	}
+
+	data, err := json.Marshal(patch)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	expected := `{"name":"Bob","nickname":null}`
+	if string(data) != expected {
+		t.Errorf("json.Marshal() = %v, want %v", string(data), expected)
+	}
+}
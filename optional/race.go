@@ -0,0 +1,37 @@
+package optional
+
+import "context"
+
+// Race runs the suppliers concurrently and returns the first present result.
+// Once a result is found the context passed to the remaining suppliers is
+// cancelled. It returns an empty Optional when every supplier returns empty or
+// when ctx is cancelled first. Suppliers should honor context cancellation so
+// that their goroutines exit promptly after Race returns
+func Race[T any](ctx context.Context, suppliers ...func(context.Context) Optional[T]) Optional[T] {
+	if len(suppliers) == 0 {
+		return Empty[T]()
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Buffered so that late suppliers never block after Race has returned
+	results := make(chan Optional[T], len(suppliers))
+	for _, supplier := range suppliers {
+		go func(supplier func(context.Context) Optional[T]) {
+			results <- supplier(ctx)
+		}(supplier)
+	}
+
+	for range suppliers {
+		select {
+		case result := <-results:
+			if result.found {
+				return result
+			}
+		case <-ctx.Done():
+			return Empty[T]()
+		}
+	}
+	return Empty[T]()
+}
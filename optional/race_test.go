@@ -0,0 +1,106 @@
+package optional
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// delayed returns a supplier that yields result after delay, or Empty if the
+// context is cancelled first. running tracks suppliers that haven't returned
+func delayed(delay time.Duration, result Optional[string], running *int32) func(context.Context) Optional[string] {
+	atomic.AddInt32(running, 1)
+	return func(ctx context.Context) Optional[string] {
+		defer atomic.AddInt32(running, -1)
+		select {
+		case <-time.After(delay):
+			return result
+		case <-ctx.Done():
+			return Empty[string]()
+		}
+	}
+}
+
+// blocking returns a supplier that only returns when its context is cancelled
+func blocking(running *int32) func(context.Context) Optional[string] {
+	atomic.AddInt32(running, 1)
+	return func(ctx context.Context) Optional[string] {
+		defer atomic.AddInt32(running, -1)
+		<-ctx.Done()
+		return Empty[string]()
+	}
+}
+
+func waitForSuppliers(t *testing.T, running *int32) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for atomic.LoadInt32(running) != 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("%d suppliers still running after Race returned", atomic.LoadInt32(running))
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestRaceReturnsFirstPresent(t *testing.T) {
+	var running int32
+	result := Race(context.Background(),
+		delayed(50*time.Millisecond, Of("slow"), &running),
+		delayed(5*time.Millisecond, Of("fast"), &running),
+		blocking(&running),
+	)
+
+	if val, ok := result.GetIfPresent(); !ok || val != "fast" {
+		t.Errorf("Expected value 'fast', got %v, present: %v", val, ok)
+	}
+	waitForSuppliers(t, &running)
+}
+
+func TestRaceSkipsEmptyResults(t *testing.T) {
+	var running int32
+	result := Race(context.Background(),
+		delayed(time.Millisecond, Empty[string](), &running),
+		delayed(20*time.Millisecond, Of("replica"), &running),
+	)
+
+	if val, ok := result.GetIfPresent(); !ok || val != "replica" {
+		t.Errorf("Expected value 'replica', got %v, present: %v", val, ok)
+	}
+	waitForSuppliers(t, &running)
+}
+
+func TestRaceAllEmpty(t *testing.T) {
+	var running int32
+	result := Race(context.Background(),
+		delayed(time.Millisecond, Empty[string](), &running),
+		delayed(5*time.Millisecond, Empty[string](), &running),
+	)
+
+	if result.IsPresent() {
+		t.Error("Race should be empty when every supplier is empty")
+	}
+	waitForSuppliers(t, &running)
+}
+
+func TestRaceContextCancelled(t *testing.T) {
+	var running int32
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	result := Race(ctx, blocking(&running), blocking(&running))
+	if result.IsPresent() {
+		t.Error("Race should be empty when the context is cancelled")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Race took %v to return after cancellation", elapsed)
+	}
+	waitForSuppliers(t, &running)
+}
+
+func TestRaceNoSuppliers(t *testing.T) {
+	if Race[string](context.Background()).IsPresent() {
+		t.Error("Race with no suppliers should be empty")
+	}
+}
@@ -0,0 +1,28 @@
+package optional
+
+import (
+	"database/sql"
+	"database/sql/driver"
+)
+
+// Scan implements the sql.Scanner interface. NULL scans to an empty Optional,
+// and any other value is stored as present after converting it to T with the
+// same rules database/sql applies to Scan destinations
+func (o *Optional[T]) Scan(src any) error {
+	var n sql.Null[T]
+	if err := n.Scan(src); err != nil {
+		return err
+	}
+	if n.Valid {
+		*o = Of(n.V)
+	} else {
+		*o = Empty[T]()
+	}
+	return nil
+}
+
+// Value implements the driver.Valuer interface. An empty Optional is stored as
+// NULL and a present value is converted to one of the standard driver types
+func (o Optional[T]) Value() (driver.Value, error) {
+	return sql.Null[T]{V: o.value, Valid: o.found}.Value()
+}
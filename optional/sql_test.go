@@ -0,0 +1,180 @@
+package optional
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestOptionalScanNull(t *testing.T) {
+	opt := Of("stale")
+	if err := opt.Scan(nil); err != nil {
+		t.Fatalf("Scan(nil) error = %v", err)
+	}
+	if opt.IsPresent() {
+		t.Error("Scan(nil) should produce an empty Optional")
+	}
+}
+
+func TestOptionalScanDriverTypes(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC)
+
+	var i64 Optional[int64]
+	var f64 Optional[float64]
+	var b Optional[bool]
+	var bs Optional[[]byte]
+	var s Optional[string]
+	var tm Optional[time.Time]
+
+	for _, scan := range []struct {
+		name string
+		dst  sql.Scanner
+		src  any
+	}{
+		{"int64", &i64, int64(42)},
+		{"float64", &f64, 3.5},
+		{"bool", &b, true},
+		{"[]byte", &bs, []byte("raw")},
+		{"string", &s, "text"},
+		{"time.Time", &tm, now},
+	} {
+		if err := scan.dst.Scan(scan.src); err != nil {
+			t.Errorf("Scan(%s) error = %v", scan.name, err)
+		}
+	}
+
+	if i64.OrElse(0) != 42 || f64.OrElse(0) != 3.5 || !b.OrElse(false) ||
+		string(bs.OrElse(nil)) != "raw" || s.OrElse("") != "text" || !tm.OrElse(time.Time{}).Equal(now) {
+		t.Errorf("Unexpected scanned values: %v %v %v %v %v %v", i64, f64, b, bs, s, tm)
+	}
+}
+
+func TestOptionalScanConversions(t *testing.T) {
+	var n Optional[int]
+	if err := n.Scan(int64(7)); err != nil || n.OrElse(0) != 7 {
+		t.Errorf("Scan(int64) into int = %v, err=%v", n.OrElse(0), err)
+	}
+
+	var f Optional[float32]
+	if err := f.Scan(float64(0.5)); err != nil || f.OrElse(0) != 0.5 {
+		t.Errorf("Scan(float64) into float32 = %v, err=%v", f.OrElse(0), err)
+	}
+
+	var s Optional[string]
+	if err := s.Scan([]byte("from bytes")); err != nil || s.OrElse("") != "from bytes" {
+		t.Errorf("Scan([]byte) into string = %v, err=%v", s.OrElse(""), err)
+	}
+
+	var text Optional[string]
+	if err := text.Scan(int64(65)); err != nil || text.OrElse("") != "65" {
+		t.Errorf("Scan(int64) into string = %v, err=%v", text.OrElse(""), err)
+	}
+
+	var u Optional[uint64]
+	if err := u.Scan(int64(12)); err != nil || u.OrElse(0) != 12 {
+		t.Errorf("Scan(int64) into uint64 = %v, err=%v", u.OrElse(0), err)
+	}
+
+	var bs Optional[[]byte]
+	if err := bs.Scan("from string"); err != nil || string(bs.OrElse(nil)) != "from string" {
+		t.Errorf("Scan(string) into []byte = %v, err=%v", bs.OrElse(nil), err)
+	}
+
+	var raw Optional[json.RawMessage]
+	if err := raw.Scan([]byte(`{"a":1}`)); err != nil || string(raw.OrElse(nil)) != `{"a":1}` {
+		t.Errorf("Scan([]byte) into json.RawMessage = %s, err=%v", raw.OrElse(nil), err)
+	}
+
+	// Scanned bytes are copied, since drivers may reuse the buffer
+	buffer := []byte("abc")
+	bs.Scan(buffer)
+	buffer[0] = 'x'
+	if string(bs.OrElse(nil)) != "abc" {
+		t.Errorf("Scan([]byte) should copy the source, got %s", bs.OrElse(nil))
+	}
+}
+
+func TestOptionalScanErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		scan    func() error
+		message string
+	}{
+		{"negative into uint64", func() error { var o Optional[uint64]; return o.Scan(int64(-1)) }, "invalid syntax"},
+		{"negative into uint", func() error { var o Optional[uint]; return o.Scan(int64(-1)) }, "invalid syntax"},
+		{"overflow", func() error { var o Optional[int8]; return o.Scan(int64(300)) }, "value out of range"},
+		{"fraction into int", func() error { var o Optional[int]; return o.Scan(1.5) }, "invalid syntax"},
+		{"text into int", func() error { var o Optional[int]; return o.Scan("abc") }, "invalid syntax"},
+		{"int64 into bool", func() error { var o Optional[bool]; return o.Scan(int64(2)) }, "couldn't convert 2 into type bool"},
+		{"time into int", func() error { var o Optional[int]; return o.Scan(time.Now()) }, "to a int"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.scan()
+			if err == nil || !strings.Contains(err.Error(), tt.message) {
+				t.Errorf("Scan() error = %v, want it to contain %q", err, tt.message)
+			}
+		})
+	}
+
+	// A failed scan leaves the destination untouched
+	o := Of(uint64(7))
+	o.Scan(int64(-1))
+	if o.OrElse(0) != 7 {
+		t.Errorf("Scan() error changed the destination to %v", o.OrElse(0))
+	}
+}
+
+func TestOptionalScanDelegatesToScanner(t *testing.T) {
+	var o Optional[sql.NullInt64]
+	if err := o.Scan(int64(9)); err != nil {
+		t.Fatalf("Scan() error = %v", err)
+	}
+	if v := o.OrElse(sql.NullInt64{}); !v.Valid || v.Int64 != 9 {
+		t.Errorf("Scan() should delegate to the element's Scan method, got %+v", v)
+	}
+}
+
+func TestOptionalValue(t *testing.T) {
+	now := time.Now()
+	type score int
+
+	tests := []struct {
+		name     string
+		valuer   driver.Valuer
+		expected driver.Value
+	}{
+		{"Empty", Empty[string](), nil},
+		{"string", Of("text"), "text"},
+		{"int widened to int64", Of(42), int64(42)},
+		{"named int", Of(score(7)), int64(7)},
+		{"float32 widened to float64", Of(float32(0.5)), float64(0.5)},
+		{"bool", Of(true), true},
+		{"time.Time", Of(now), now},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, err := tt.valuer.Value()
+			if err != nil {
+				t.Fatalf("Value() error = %v", err)
+			}
+			if value != tt.expected {
+				t.Errorf("Value() = %#v, want %#v", value, tt.expected)
+			}
+		})
+	}
+
+	bytesValue, err := Of([]byte("raw")).Value()
+	if err != nil || string(bytesValue.([]byte)) != "raw" {
+		t.Errorf("Value() for []byte = %v, err=%v", bytesValue, err)
+	}
+
+	if _, err := Of(struct{ A int }{1}).Value(); err == nil {
+		t.Error("Value() should fail for types the driver can't store")
+	}
+}